@@ -50,6 +50,10 @@ func PreTranslateMessage(msg *w32.MSG) bool {
 	// keyboard accelerator keys and calls Controller.PreTranslateMessage for
 	// keyboard and mouse events.
 
+	if handleDragMessage(msg) {
+		return true
+	}
+
 	processed := false
 
 	if (msg.Message >= w32.WM_KEYFIRST && msg.Message <= w32.WM_KEYLAST) ||
@@ -82,6 +86,7 @@ func PostMessages() {
 				w32.DispatchMessage(&m)
 			}
 		}
+		drainInvokes()
 	}
 }
 
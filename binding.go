@@ -0,0 +1,151 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// ControlAdapter is the control side of a Binding.
+// Adapters for the windigo controls are made by AdaptControl.
+type ControlAdapter = core.ControlAdapter
+
+// FieldAccessor is the data side of a Binding.
+type FieldAccessor = core.FieldAccessor
+
+// Accessor makes a FieldAccessor from getter and setter funcs.
+func Accessor[T any](get func() T, set func(T)) FieldAccessor {
+	return core.Accessor(get, set)
+}
+
+// FieldOf makes a FieldAccessor for the exported field name of the struct ptr points to.
+func FieldOf(ptr any, name string) (FieldAccessor, error) {
+	return core.FieldOf(ptr, name)
+}
+
+// Converter translates between field and control values.
+type Converter = core.Converter
+
+// IntConverter shows an int field as text.
+type IntConverter = core.IntConverter
+
+// FloatConverter shows a float64 field as text, see strconv.FormatFloat.
+type FloatConverter = core.FloatConverter
+
+// TimeConverter shows a time.Time field as text in Layout.
+type TimeConverter = core.TimeConverter
+
+// EnumConverter maps a field to the index of its value in Values.
+type EnumConverter = core.EnumConverter
+
+// Binding keeps a field and a control in sync, see core.Binding.
+type Binding struct {
+	binding *core.Binding
+
+	onChange EventManager
+}
+
+// NewBinding binds field to control. A nil converter passes values unchanged.
+func NewBinding(field FieldAccessor, control ControlAdapter, converter Converter) *Binding {
+	return newBinding(core.NewBinding(field, control, converter))
+}
+
+func newBinding(b *core.Binding) *Binding {
+	binding := &Binding{binding: b}
+	b.Changed = func(*core.Binding) {
+		binding.onChange.Fire(NewEvent(nil, binding))
+	}
+	return binding
+}
+
+// Load copies the field into the control and clears the dirty flag.
+func (binding *Binding) Load() error {
+	return binding.binding.Load()
+}
+
+// Save copies the control into the field and clears the dirty flag.
+func (binding *Binding) Save() error {
+	return binding.binding.Save()
+}
+
+// Dirty reports whether the control changed since the last Load or Save.
+func (binding *Binding) Dirty() bool {
+	return binding.binding.Dirty()
+}
+
+// Err is the conversion error of the last change, if any.
+func (binding *Binding) Err() error {
+	return binding.binding.Err()
+}
+
+// OnChange fires after the user changed the control. Event.Data is the *Binding.
+func (binding *Binding) OnChange() *EventManager {
+	return &binding.onChange
+}
+
+// BindingGroup holds the bindings of a form.
+type BindingGroup struct {
+	group    *core.BindingGroup
+	bindings []*Binding
+
+	onDirtyChange EventManager
+}
+
+func NewBindingGroup() *BindingGroup {
+	group := &BindingGroup{group: core.NewBindingGroup()}
+	group.group.DirtyChanged = func(dirty bool) {
+		group.onDirtyChange.Fire(NewEvent(nil, dirty))
+	}
+	return group
+}
+
+// Bind adds a binding of field to control.
+func (group *BindingGroup) Bind(field FieldAccessor, control ControlAdapter, converter Converter) *Binding {
+	binding := newBinding(group.group.Bind(field, control, converter))
+	group.bindings = append(group.bindings, binding)
+	return binding
+}
+
+// BindField binds the exported field name of the struct ptr points to.
+func (group *BindingGroup) BindField(ptr any, name string, control ControlAdapter, converter Converter) (*Binding, error) {
+	field, err := FieldOf(ptr, name)
+	if err != nil {
+		return nil, err
+	}
+	return group.Bind(field, control, converter), nil
+}
+
+func (group *BindingGroup) Bindings() []*Binding {
+	return group.bindings
+}
+
+// Load copies every field into its control.
+func (group *BindingGroup) Load() error {
+	return group.group.Load()
+}
+
+// Save copies every control into its field.
+func (group *BindingGroup) Save() error {
+	return group.group.Save()
+}
+
+// Dirty reports whether any control changed since it was loaded or saved.
+func (group *BindingGroup) Dirty() bool {
+	return group.group.Dirty()
+}
+
+// DirtyBindings returns the bindings whose control changed.
+func (group *BindingGroup) DirtyBindings() []*Binding {
+	var dirty []*Binding
+	for _, binding := range group.bindings {
+		if binding.Dirty() {
+			dirty = append(dirty, binding)
+		}
+	}
+	return dirty
+}
+
+// OnDirtyChange fires when Dirty changes. Event.Data is the new Dirty value.
+func (group *BindingGroup) OnDirtyChange() *EventManager {
+	return &group.onDirtyChange
+}
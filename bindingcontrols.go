@@ -0,0 +1,117 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "fmt"
+
+// editAdapter binds the text of an Edit.
+type editAdapter struct {
+	edit *Edit
+}
+
+func (adapter editAdapter) Value() any { return adapter.edit.Text() }
+
+func (adapter editAdapter) SetValue(value any) {
+	text, _ := value.(string)
+	adapter.edit.SetText(text)
+	adapter.edit.SetModified(false)
+}
+
+func (adapter editAdapter) OnValueChange(notify func()) {
+	adapter.edit.OnChange().Subscribe(func(*Event) { notify() })
+}
+
+// comboIndexAdapter binds the selected item of a ComboBox.
+type comboIndexAdapter struct {
+	combo *ComboBox
+}
+
+func (adapter comboIndexAdapter) Value() any { return adapter.combo.SelectedItem() }
+
+func (adapter comboIndexAdapter) SetValue(value any) {
+	index, ok := value.(int)
+	if !ok {
+		index = -1
+	}
+	adapter.combo.SetSelectedItem(index)
+}
+
+func (adapter comboIndexAdapter) OnValueChange(notify func()) {
+	adapter.combo.OnSelectedChange().Subscribe(func(*Event) { notify() })
+}
+
+// comboTextAdapter binds the text of an editable ComboBox.
+type comboTextAdapter struct {
+	combo *ComboBox
+}
+
+func (adapter comboTextAdapter) Value() any { return adapter.combo.Text() }
+
+func (adapter comboTextAdapter) SetValue(value any) {
+	text, _ := value.(string)
+	adapter.combo.SetText(text)
+}
+
+func (adapter comboTextAdapter) OnValueChange(notify func()) {
+	adapter.combo.OnChange().Subscribe(func(*Event) { notify() })
+	// The text is not updated yet when the selection changes.
+	adapter.combo.OnSelectedEnd().Subscribe(func(*Event) { notify() })
+}
+
+// checkAdapter binds the check of a CheckBox or RadioButton.
+type checkAdapter struct {
+	button *Button
+}
+
+func (adapter checkAdapter) Value() any { return adapter.button.Checked() }
+
+func (adapter checkAdapter) SetValue(value any) {
+	checked, _ := value.(bool)
+	adapter.button.SetChecked(checked)
+}
+
+func (adapter checkAdapter) OnValueChange(notify func()) {
+	adapter.button.OnClick().Subscribe(func(*Event) { notify() })
+}
+
+// AdaptControl returns the ControlAdapter for an Edit, ComboBox, CheckBox, RadioButton
+// or their Labeled composites. Combo boxes bind their selected index, use
+// AdaptComboBoxText to bind the text instead.
+func AdaptControl(control any) (ControlAdapter, error) {
+	switch control := control.(type) {
+	case ControlAdapter:
+		return control, nil
+	case *Edit:
+		return editAdapter{control}, nil
+	case *LabeledEdit:
+		return editAdapter{control.Edit}, nil
+	case *ComboBox:
+		return comboIndexAdapter{control}, nil
+	case *LabeledComboBox:
+		return comboIndexAdapter{control.ComboBox}, nil
+	case *CheckBox:
+		return checkAdapter{&control.Button}, nil
+	case *RadioButton:
+		return checkAdapter{&control.Button}, nil
+	case *LabeledCheckBox:
+		return checkAdapter{&control.CheckBox.Button}, nil
+	}
+	return nil, fmt.Errorf("windigo: cannot bind %T", control)
+}
+
+// AdaptComboBoxText binds the text of combo instead of its selected index.
+func AdaptComboBoxText(combo *ComboBox) ControlAdapter {
+	return comboTextAdapter{combo}
+}
+
+// BindControl binds the exported field name of the struct ptr points to
+// to one of the controls accepted by AdaptControl.
+func (group *BindingGroup) BindControl(ptr any, name string, control any, converter Converter) (*Binding, error) {
+	adapter, err := AdaptControl(control)
+	if err != nil {
+		return nil, err
+	}
+	return group.BindField(ptr, name, adapter, converter)
+}
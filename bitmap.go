@@ -6,7 +6,9 @@
 package windigo
 
 import (
+	"encoding/binary"
 	"errors"
+	"image"
 	"unsafe"
 
 	"github.com/samuel-jimenez/windigo/w32"
@@ -86,6 +88,54 @@ func NewBitmapFromResource(instance w32.HINSTANCE, resName *uint16, resType *uin
 	return assembleBitmapFromHBITMAP(hbitmap)
 }
 
+// NewBitmapFromImage creates a 32 bit bitmap holding img.
+func NewBitmapFromImage(img image.Image) (*Bitmap, error) {
+	data := EncodeDIB(img)
+	info := (*w32.BITMAPINFO)(unsafe.Pointer(&data[0]))
+	var bits unsafe.Pointer
+	hbitmap := w32.CreateDIBSection(0, info, w32.DIB_RGB_COLORS, &bits, 0, 0)
+	if hbitmap == 0 {
+		return nil, errors.New("CreateDIBSection failed")
+	}
+	if pixels := data[dibHeaderSize:]; len(pixels) > 0 {
+		copy(unsafe.Slice((*byte)(bits), len(pixels)), pixels)
+	}
+	return assembleBitmapFromHBITMAP(hbitmap)
+}
+
+// DIB returns the bitmap as a 32 bit device independent bitmap, see DecodeDIB.
+func (bm *Bitmap) DIB() ([]byte, error) {
+	// Top-down bitmaps have a negative height.
+	width, height := bm.width, max(bm.height, -bm.height)
+	if width <= 0 || height == 0 {
+		return nil, errors.New("bitmap is empty")
+	}
+	data := make([]byte, dibHeaderSize+4*width*height)
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], dibHeaderSize)
+	le.PutUint32(data[4:], uint32(width))
+	le.PutUint32(data[8:], uint32(height))
+	le.PutUint16(data[12:], 1) // planes
+	le.PutUint16(data[14:], 32)
+
+	hdc := w32.GetDC(0)
+	defer w32.ReleaseDC(0, hdc)
+	info := (*w32.BITMAPINFO)(unsafe.Pointer(&data[0]))
+	if w32.GetDIBits(hdc, bm.handle, 0, uint(height), unsafe.Pointer(&data[dibHeaderSize]), info, w32.DIB_RGB_COLORS) == 0 {
+		return nil, errors.New("GetDIBits failed")
+	}
+	return data, nil
+}
+
+// Image returns a copy of the bitmap pixels.
+func (bm *Bitmap) Image() (image.Image, error) {
+	data, err := bm.DIB()
+	if err != nil {
+		return nil, err
+	}
+	return DecodeDIB(data)
+}
+
 func (bm *Bitmap) Dispose() {
 	if bm.handle != 0 {
 		w32.DeleteObject(w32.HGDIOBJ(bm.handle))
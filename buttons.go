@@ -55,6 +55,25 @@ func (control *Button) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	//return control.W32Control.WndProc(msg, wparam, lparam)
 }
 
+// PreferredSize fits the caption with room for the button frame.
+func (control *Button) PreferredSize() (width, height int) {
+	width, height = control.textSize(control.Text())
+	return control.clampSize(width+16, height+10)
+}
+
+func (control *Button) MinimumSize() (width, height int) {
+	width, height = control.textSize(control.Text())
+	return control.clampSize(width+6, height+6)
+}
+
+// checkSize fits the caption next to a check box or radio button glyph.
+func (control *Button) checkSize() (width, height int) {
+	width, height = control.textSize(control.Text())
+	check_width := w32.GetSystemMetrics(w32.SM_CXMENUCHECK)
+	check_height := w32.GetSystemMetrics(w32.SM_CYMENUCHECK)
+	return control.clampSize(check_width+width+6, max(check_height, height)+4)
+}
+
 func (control *Button) Checked() bool {
 	result := w32.SendMessage(control.hwnd, w32.BM_GETCHECK, 0, 0)
 	return result == w32.BST_CHECKED
@@ -139,6 +158,14 @@ func NewCheckBox(parent Controller) *CheckBox {
 	return control
 }
 
+func (control *CheckBox) PreferredSize() (width, height int) {
+	return control.checkSize()
+}
+
+func (control *CheckBox) MinimumSize() (width, height int) {
+	return control.checkSize()
+}
+
 type RadioButton struct {
 	Button
 }
@@ -156,6 +183,14 @@ func NewRadioButton(parent Controller) *RadioButton {
 	return control
 }
 
+func (control *RadioButton) PreferredSize() (width, height int) {
+	return control.checkSize()
+}
+
+func (control *RadioButton) MinimumSize() (width, height int) {
+	return control.checkSize()
+}
+
 type GroupBox struct {
 	Button
 }
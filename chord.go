@@ -0,0 +1,39 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"time"
+
+	"github.com/samuel-jimenez/windigo/core"
+)
+
+// See core.Chord.
+type Chord = core.Chord
+
+// See core.ParseChord.
+func ParseChord(text string) (Chord, error) {
+	return core.ParseChord(text)
+}
+
+// See core.ChordResult.
+type ChordResult = core.ChordResult
+
+// Values of ChordResult, see core.ChordResult.
+const (
+	ChordNone           = core.ChordNone
+	ChordPending        = core.ChordPending
+	ChordMatched        = core.ChordMatched
+	ChordCanceled       = core.ChordCanceled
+	ChordMatchedPending = core.ChordMatchedPending
+)
+
+// See core.ChordMatcher.
+type ChordMatcher = core.ChordMatcher
+
+// See core.NewChordMatcher.
+func NewChordMatcher(timeout time.Duration) *ChordMatcher {
+	return core.NewChordMatcher(timeout)
+}
@@ -0,0 +1,247 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"errors"
+	"image"
+	"time"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// ClipboardFormat identifies a kind of clipboard data.
+type ClipboardFormat uint
+
+const (
+	ClipboardText  ClipboardFormat = w32.CF_UNICODETEXT
+	ClipboardDIB   ClipboardFormat = w32.CF_DIB
+	ClipboardFiles ClipboardFormat = w32.CF_HDROP
+)
+
+// ClipboardHTML is the "HTML Format" of browsers and office programs, see HTMLClipboardData.
+var ClipboardHTML = RegisterClipboardFormat("HTML Format")
+
+// ErrClipboardFormat is returned when the clipboard holds no data in the format read.
+var ErrClipboardFormat = errors.New("clipboard has no data in that format")
+
+// RegisterClipboardFormat returns the format registered as name, registering it the first time.
+// Programs that register the same name share the format.
+func RegisterClipboardFormat(name string) ClipboardFormat {
+	return ClipboardFormat(w32.RegisterClipboardFormat(name))
+}
+
+// ClipboardData is the data of one clipboard format, see Clipboard.Write.
+type ClipboardData struct {
+	Format ClipboardFormat
+	Data   []byte
+}
+
+// TextData holds text as the NUL terminated UTF-16 of ClipboardText.
+func TextData(text string) ClipboardData {
+	chars := append(utf16.Encode([]rune(text)), 0)
+	return ClipboardData{ClipboardText, unsafe.Slice((*byte)(unsafe.Pointer(&chars[0])), 2*len(chars))}
+}
+
+// ImageData holds img as ClipboardDIB.
+func ImageData(img image.Image) ClipboardData {
+	return ClipboardData{ClipboardDIB, EncodeDIB(img)}
+}
+
+// Clipboard reads and writes the system clipboard for its owner window.
+type Clipboard struct {
+	owner    w32.HWND
+	onChange EventManager
+}
+
+// Clipboards that Listen, by owner.
+var gClipboardListeners = make(map[w32.HWND]*Clipboard)
+
+// NewClipboard returns the clipboard of owner. A nil owner can read and write but not Listen.
+func NewClipboard(owner Controller) *Clipboard {
+	clipboard := &Clipboard{}
+	if owner != nil {
+		clipboard.owner = owner.Handle()
+	}
+	return clipboard
+}
+
+// open the clipboard, waiting a little while another program holds it.
+func (clipboard *Clipboard) open() error {
+	for range 5 {
+		if w32.OpenClipboard(clipboard.owner) {
+			return nil
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return errors.New("OpenClipboard failed")
+}
+
+// HasFormat reports whether the clipboard holds data in format.
+func (clipboard *Clipboard) HasFormat(format ClipboardFormat) bool {
+	return w32.IsClipboardFormatAvailable(uint(format))
+}
+
+// Formats returns the formats of the clipboard data, in the order the program that wrote it prefers.
+func (clipboard *Clipboard) Formats() ([]ClipboardFormat, error) {
+	if err := clipboard.open(); err != nil {
+		return nil, err
+	}
+	defer w32.CloseClipboard()
+
+	var formats []ClipboardFormat
+	for format := w32.EnumClipboardFormats(0); format != 0; format = w32.EnumClipboardFormats(format) {
+		formats = append(formats, ClipboardFormat(format))
+	}
+	return formats, nil
+}
+
+// Clear empties the clipboard.
+func (clipboard *Clipboard) Clear() error {
+	return clipboard.Write()
+}
+
+// Write replaces the clipboard contents with data, opening it once
+// so other programs see all formats together.
+func (clipboard *Clipboard) Write(data ...ClipboardData) error {
+	if err := clipboard.open(); err != nil {
+		return err
+	}
+	defer w32.CloseClipboard()
+
+	w32.EmptyClipboard()
+	for _, entry := range data {
+		mem, err := w32.TryGlobalAlloc(w32.GMEM_MOVEABLE, uint32(max(len(entry.Data), 1)))
+		if err != nil {
+			return err
+		}
+		if len(entry.Data) > 0 {
+			w32.MoveMemory(w32.GlobalLock(mem), unsafe.Pointer(&entry.Data[0]), uint32(len(entry.Data)))
+			w32.GlobalUnlock(mem)
+		}
+		// The clipboard owns mem once it is set.
+		if w32.SetClipboardData(uint(entry.Format), mem) == 0 {
+			w32.GlobalFree(mem)
+			return errors.New("SetClipboardData failed")
+		}
+	}
+	return nil
+}
+
+// Read returns a copy of the clipboard data in format.
+func (clipboard *Clipboard) Read(format ClipboardFormat) ([]byte, error) {
+	if err := clipboard.open(); err != nil {
+		return nil, err
+	}
+	defer w32.CloseClipboard()
+
+	mem := w32.GetClipboardData(uint(format))
+	if mem == 0 {
+		return nil, ErrClipboardFormat
+	}
+	data := make([]byte, w32.GlobalSize(mem))
+	if len(data) > 0 {
+		w32.MoveMemory(unsafe.Pointer(&data[0]), w32.GlobalLock(mem), uint32(len(data)))
+		w32.GlobalUnlock(mem)
+	}
+	return data, nil
+}
+
+func (clipboard *Clipboard) WriteText(text string) error {
+	return clipboard.Write(TextData(text))
+}
+
+func (clipboard *Clipboard) ReadText() (string, error) {
+	data, err := clipboard.Read(ClipboardText)
+	if err != nil {
+		return "", err
+	}
+	chars := unsafe.Slice((*uint16)(unsafe.Pointer(unsafe.SliceData(data))), len(data)/2)
+	for i, char := range chars {
+		if char == 0 {
+			chars = chars[:i]
+			break
+		}
+	}
+	return string(utf16.Decode(chars)), nil
+}
+
+func (clipboard *Clipboard) WriteImage(img image.Image) error {
+	return clipboard.Write(ImageData(img))
+}
+
+// ReadImage decodes the ClipboardDIB data, see DecodeDIB.
+// Windows provides it for images copied as bitmaps.
+func (clipboard *Clipboard) ReadImage() (image.Image, error) {
+	data, err := clipboard.Read(ClipboardDIB)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeDIB(data)
+}
+
+func (clipboard *Clipboard) WriteBitmap(bitmap *Bitmap) error {
+	data, err := bitmap.DIB()
+	if err != nil {
+		return err
+	}
+	return clipboard.Write(ClipboardData{ClipboardDIB, data})
+}
+
+// ReadBitmap returns the clipboard image as a new Bitmap, which the caller disposes.
+func (clipboard *Clipboard) ReadBitmap() (*Bitmap, error) {
+	img, err := clipboard.ReadImage()
+	if err != nil {
+		return nil, err
+	}
+	return NewBitmapFromImage(img)
+}
+
+// ReadFiles returns the paths of files copied in Explorer.
+func (clipboard *Clipboard) ReadFiles() ([]string, error) {
+	if err := clipboard.open(); err != nil {
+		return nil, err
+	}
+	defer w32.CloseClipboard()
+
+	// The clipboard owns hDrop, so it is not finished.
+	hDrop := w32.HDROP(w32.GetClipboardData(w32.CF_HDROP))
+	if hDrop == 0 {
+		return nil, ErrClipboardFormat
+	}
+	_, count := w32.DragQueryFile(hDrop, 0xFFFFFFFF)
+	files := make([]string, count)
+	for i := range count {
+		files[i], _ = w32.DragQueryFile(hDrop, i)
+	}
+	return files, nil
+}
+
+// Listen fires OnChange when the clipboard contents change, until StopListening.
+func (clipboard *Clipboard) Listen() error {
+	if clipboard.owner == 0 {
+		return errors.New("clipboard has no owner window to listen with")
+	}
+	// A window is added once, another Clipboard of the owner stops listening.
+	if gClipboardListeners[clipboard.owner] == nil && !w32.AddClipboardFormatListener(clipboard.owner) {
+		return errors.New("AddClipboardFormatListener failed")
+	}
+	gClipboardListeners[clipboard.owner] = clipboard
+	return nil
+}
+
+func (clipboard *Clipboard) StopListening() {
+	if gClipboardListeners[clipboard.owner] == clipboard {
+		w32.RemoveClipboardFormatListener(clipboard.owner)
+		delete(gClipboardListeners, clipboard.owner)
+	}
+}
+
+// OnChange fires when the clipboard contents change, see Listen. Event.Sender is the owner.
+func (clipboard *Clipboard) OnChange() *EventManager {
+	return &clipboard.onChange
+}
@@ -5,22 +5,12 @@
 
 package windigo
 
-type Color uint32
+import "github.com/samuel-jimenez/windigo/core"
 
-func RGB(r, g, b byte) Color {
-	return Color(uint32(r) | uint32(g)<<8 | uint32(b)<<16)
-}
-
-func (c Color) R() byte {
-	return byte(c & 0xff)
-}
+type Color = core.Color
 
-func (c Color) G() byte {
-	return byte((c >> 8) & 0xff)
-}
-
-func (c Color) B() byte {
-	return byte((c >> 16) & 0xff)
+func RGB(r, g, b byte) Color {
+	return core.RGB(r, g, b)
 }
 
 func init() {
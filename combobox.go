@@ -197,6 +197,25 @@ func (control *ComboBox) GetItem(index int) string {
 	return syscall.UTF16ToString(buf)
 }
 
+// PreferredSize fits the longest item next to the drop down button.
+// Height is that of the closed field, the list sizes itself.
+func (control *ComboBox) PreferredSize() (width, height int) {
+	text_width, _ := control.textSize(control.Text())
+	count := int(int32(w32.SendMessage(control.hwnd, w32.CB_GETCOUNT, 0, 0)))
+	for i := range count {
+		item_width, _ := control.textSize(control.GetItem(i))
+		text_width = max(text_width, item_width)
+	}
+	text_width = max(text_width, editMinimumChars*control.measureFont().AverageCharWidth())
+	width, height = control.fieldSize(text_width + w32.GetSystemMetrics(w32.SM_CXVSCROLL))
+	return control.ControlBase.clampSize(width, height)
+}
+
+func (control *ComboBox) MinimumSize() (width, height int) {
+	width, height = control.fieldSize(editMinimumChars*control.measureFont().AverageCharWidth() + w32.GetSystemMetrics(w32.SM_CXVSCROLL))
+	return control.ControlBase.clampSize(width, height)
+}
+
 func (control *ComboBox) SelectedItem() int {
 	return int(int32(w32.SendMessage(control.hwnd, w32.CB_GETCURSEL, 0, 0)))
 }
@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"io"
+
+	"github.com/samuel-jimenez/windigo/core"
+)
+
+// Command is an action that menus, tool buttons and shortcuts run, see Commands.
+type Command = core.Command
+
+// CommandBinding is a command and its shortcut, see Commands.Bindings.
+type CommandBinding = core.CommandBinding
+
+// ShortcutConflict is a shortcut bound to several commands.
+type ShortcutConflict = core.ShortcutConflict
+
+// Commands is a registry of commands, see core.Commands.
+// Menu items and tool buttons added with AddMenuItem and AddToolButton follow
+// the commands, and Form.SetCommands runs them from the keyboard.
+type Commands struct {
+	commands *core.Commands
+
+	menuItems map[string][]*MenuItem
+	buttons   map[string][]*ToolButton
+}
+
+func NewCommands() *Commands {
+	commands := &Commands{
+		commands:  core.NewCommands(),
+		menuItems: make(map[string][]*MenuItem),
+		buttons:   make(map[string][]*ToolButton),
+	}
+	commands.commands.Changed = commands.updateShortcut
+	return commands
+}
+
+// Register adds cmd. IDs must be unique.
+func (commands *Commands) Register(cmd Command) (*Command, error) {
+	return commands.commands.Register(cmd)
+}
+
+// Command returns the command id, or nil.
+func (commands *Commands) Command(id string) *Command {
+	return commands.commands.Command(id)
+}
+
+// All returns the commands in registration order.
+func (commands *Commands) All() []*Command {
+	return commands.commands.All()
+}
+
+// Shortcut returns the shortcut of command id, NoShortcut if it has none.
+func (commands *Commands) Shortcut(id string) Shortcut {
+	return commands.commands.Shortcut(id)
+}
+
+// Rebound reports whether the shortcut of command id is not its default.
+func (commands *Commands) Rebound(id string) bool {
+	return commands.commands.Rebound(id)
+}
+
+// SetShortcut rebinds command id, see core.Commands.SetShortcut.
+func (commands *Commands) SetShortcut(id string, shortcut Shortcut) error {
+	return commands.commands.SetShortcut(id, shortcut)
+}
+
+// ResetShortcuts gives all commands their default shortcuts.
+func (commands *Commands) ResetShortcuts() {
+	commands.commands.ResetShortcuts()
+}
+
+// Lookup returns the commands bound to shortcut, in registration order.
+func (commands *Commands) Lookup(shortcut Shortcut) []*Command {
+	return commands.commands.Lookup(shortcut)
+}
+
+// Conflicting returns the commands other than id that are bound to shortcut.
+func (commands *Commands) Conflicting(id string, shortcut Shortcut) []*Command {
+	return commands.commands.Conflicting(id, shortcut)
+}
+
+// Conflicts returns the shortcuts bound to several commands, see core.Commands.Conflicts.
+func (commands *Commands) Conflicts() []ShortcutConflict {
+	return commands.commands.Conflicts()
+}
+
+// Bindings returns every command with its shortcut in registration order.
+func (commands *Commands) Bindings() []CommandBinding {
+	return commands.commands.Bindings()
+}
+
+// Run runs command id if it is enabled, see core.Commands.Run.
+func (commands *Commands) Run(id string) bool {
+	return commands.commands.Run(id)
+}
+
+// RunShortcut runs the first enabled command bound to shortcut and reports whether one ran.
+func (commands *Commands) RunShortcut(shortcut Shortcut) bool {
+	return commands.commands.RunShortcut(shortcut)
+}
+
+// Chords returns the shortcuts and chords of the commands, as chords.
+func (commands *Commands) Chords() []Chord {
+	return commands.commands.Chords()
+}
+
+// RunChord runs the first enabled command with chord, see core.Commands.RunChord.
+func (commands *Commands) RunChord(chord Chord) bool {
+	return commands.commands.RunChord(chord)
+}
+
+// SaveShortcuts writes the rebound shortcuts as JSON, see core.Commands.SaveShortcuts.
+func (commands *Commands) SaveShortcuts(w io.Writer) error {
+	return commands.commands.SaveShortcuts(w)
+}
+
+// LoadShortcuts replaces the rebound shortcuts with those written by SaveShortcuts,
+// see core.Commands.LoadShortcuts.
+func (commands *Commands) LoadShortcuts(r io.Reader) error {
+	return commands.commands.LoadShortcuts(r)
+}
+
+// adopt registers command id of from, with its shortcut and menu items, unless
+// commands has it already.
+func (commands *Commands) adopt(from *Commands, id string) {
+	cmd := from.Command(id)
+	if cmd == nil || commands.Command(id) != nil {
+		return
+	}
+	commands.Register(*cmd)
+	commands.menuItems[id] = from.menuItems[id]
+	commands.buttons[id] = from.buttons[id]
+	if from.Rebound(id) {
+		commands.SetShortcut(id, from.Shortcut(id))
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+// AddMenuItem adds an item to menu that shows the title and shortcut of command id and runs it.
+func (commands *Commands) AddMenuItem(menu *MenuItem, id string) *MenuItem {
+	cmd := commands.Command(id)
+	if cmd == nil {
+		panic("unknown command " + id)
+	}
+	item := menu.AddItem(cmd.Title, NoShortcut)
+	item.command = true
+	item.OnClick().Bind(func(*Event) { commands.Run(id) })
+	commands.menuItems[id] = append(commands.menuItems[id], item)
+	commands.updateMenuItem(item, cmd)
+	return item
+}
+
+// AddToolButton adds a button to toolbar that shows the title of command id and runs it.
+func (commands *Commands) AddToolButton(toolbar *Toolbar, id string, image int) *ToolButton {
+	cmd := commands.Command(id)
+	if cmd == nil {
+		panic("unknown command " + id)
+	}
+	button := toolbar.AddButton(cmd.Title, image)
+	button.OnClick().Bind(func(*Event) { commands.Run(id) })
+	commands.buttons[id] = append(commands.buttons[id], button)
+	if !cmd.IsEnabled() {
+		button.SetEnabled(false)
+	}
+	return button
+}
+
+// Update enables or disables the menu items and tool buttons of the commands.
+// Form calls it when a menu opens; call it when the state of a tool button command changes.
+func (commands *Commands) Update() {
+	for _, cmd := range commands.All() {
+		for _, item := range commands.menuItems[cmd.ID] {
+			commands.updateMenuItem(item, cmd)
+		}
+		enabled := cmd.IsEnabled()
+		for _, button := range commands.buttons[cmd.ID] {
+			if button.Enabled() != enabled {
+				button.SetEnabled(enabled)
+			}
+		}
+	}
+}
+
+func (commands *Commands) updateMenuItem(item *MenuItem, cmd *Command) {
+	enabled, shortcut := cmd.IsEnabled(), commands.Shortcut(cmd.ID)
+	if item.enabled != enabled || item.shortcut != shortcut {
+		item.enabled, item.shortcut = enabled, shortcut
+		item.update()
+	}
+}
+
+// updateShortcut shows the new shortcut of command id on its menu items.
+func (commands *Commands) updateShortcut(id string) {
+	if cmd := commands.Command(id); cmd != nil {
+		for _, item := range commands.menuItems[id] {
+			commands.updateMenuItem(item, cmd)
+		}
+	}
+}
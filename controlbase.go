@@ -69,30 +69,13 @@ type ControlBase struct {
 	onSize  EventManager
 }
 
+// ClipboardCopyText replaces the clipboard contents with text, see Clipboard.
 func (control *ControlBase) ClipboardCopyText(text string) bool {
-	if !w32.OpenClipboard(control.hwnd) {
-		return false
-	}
-
-	w32.EmptyClipboard()
+	clipboard := &Clipboard{owner: control.hwnd}
 	if len(text) == 0 {
-		w32.CloseClipboard()
-		return true
+		return clipboard.Clear() == nil
 	}
-
-	//allocate and fill buffer
-	bufferSize := uint32(len(text)) * 16 //sizeof(TCHAR))
-	globalBuffer := w32.GlobalAlloc(w32.GMEM_MOVEABLE, bufferSize)
-	pGlobalBuffer := w32.GlobalLock(globalBuffer)
-	w32.MoveMemory(pGlobalBuffer, unsafe.Pointer(syscall.StringToUTF16Ptr(text)), bufferSize)
-	w32.GlobalUnlock(globalBuffer)
-
-	// Place the handle on the clipboard.
-	w32.SetClipboardData(w32.CF_UNICODETEXT, globalBuffer)
-
-	// Close the clipboard.
-	w32.CloseClipboard()
-	return true
+	return clipboard.WriteText(text) == nil
 }
 
 // initControl is called by controls: edit, button, treeview, listview, and so on.
@@ -255,6 +238,16 @@ func (control *ControlBase) SetMaxSize(width, height int) {
 	}
 }
 
+// MinSize returns the size set by SetMinSize. Zero means unconstrained.
+func (control *ControlBase) MinSize() (width, height int) {
+	return control.minWidth, control.minHeight
+}
+
+// MaxSize returns the size set by SetMaxSize. Zero means unconstrained.
+func (control *ControlBase) MaxSize() (width, height int) {
+	return control.maxWidth, control.maxHeight
+}
+
 func (control *ControlBase) Size() (width, height int) {
 	rect := w32.GetWindowRect(control.hwnd)
 	width = int(rect.Right - rect.Left)
@@ -262,6 +255,38 @@ func (control *ControlBase) Size() (width, height int) {
 	return
 }
 
+// PreferredSize is the size the control would like to have.
+// Controls showing text compute it from their font, others keep their current size.
+func (control *ControlBase) PreferredSize() (width, height int) {
+	return control.clampSize(control.Size())
+}
+
+// MinimumSize is the smallest size the control is still usable at.
+func (control *ControlBase) MinimumSize() (width, height int) {
+	return control.minWidth, control.minHeight
+}
+
+// fieldSize fits text in an edit field with a client edge.
+func (control *ControlBase) fieldSize(text_width int) (width, height int) {
+	_, text_height := control.textSize("")
+	edge_width := 2*w32.GetSystemMetrics(w32.SM_CXEDGE) + 4
+	edge_height := 2*w32.GetSystemMetrics(w32.SM_CYEDGE) + 4
+	return text_width + edge_width, text_height + edge_height
+}
+
+// measureFont is the font text is measured in.
+func (control *ControlBase) measureFont() *Font {
+	if control.font == nil {
+		return DefaultFont
+	}
+	return control.font
+}
+
+// textSize measures text in the control font.
+func (control *ControlBase) textSize(text string) (width, height int) {
+	return control.measureFont().TextSize(text)
+}
+
 func (control *ControlBase) Width() int {
 	rect := w32.GetWindowRect(control.hwnd)
 	return int(rect.Right - rect.Left)
@@ -572,7 +597,7 @@ func (control *ControlBase) InvokeRequired() bool {
 	}
 
 	windowThreadId, _ := w32.GetWindowThreadProcessId(control.hwnd)
-	currentThreadId := w32.GetCurrentThread()
+	currentThreadId := w32.GetCurrentThreadId()
 
 	return windowThreadId != currentThreadId
 }
@@ -672,6 +697,7 @@ func (control *ControlBase) RunMainLoop() int {
 				w32.DispatchMessage(&m)
 			}
 		}
+		drainInvokes()
 	}
 
 	w32.GdiplusShutdown()
@@ -97,4 +97,5 @@ type Controller interface {
 	BaseController
 	Dockable
 	Bordered
+	SizeHinter
 }
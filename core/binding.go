@@ -0,0 +1,348 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ControlAdapter is the control side of a Binding.
+// Adapters for the windigo controls are made by windigo.AdaptControl.
+type ControlAdapter interface {
+	// Value shown by the control: string for text, int for a selected index, bool for a check.
+	Value() any
+	SetValue(value any)
+	// OnValueChange calls notify whenever the user changes the value.
+	OnValueChange(notify func())
+}
+
+// FieldAccessor is the data side of a Binding.
+type FieldAccessor struct {
+	Get func() any
+	Set func(value any) error
+}
+
+// Accessor makes a FieldAccessor from getter and setter funcs.
+func Accessor[T any](get func() T, set func(T)) FieldAccessor {
+	return FieldAccessor{
+		Get: func() any { return get() },
+		Set: func(value any) error {
+			typed, ok := value.(T)
+			if !ok {
+				return fmt.Errorf("windigo: cannot assign %T to %T", value, *new(T))
+			}
+			set(typed)
+			return nil
+		},
+	}
+}
+
+// FieldOf makes a FieldAccessor for the exported field name of the struct ptr points to.
+func FieldOf(ptr any, name string) (FieldAccessor, error) {
+	value := reflect.ValueOf(ptr)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return FieldAccessor{}, fmt.Errorf("windigo: FieldOf needs a pointer to a struct, got %T", ptr)
+	}
+	field := value.Elem().FieldByName(name)
+	if !field.IsValid() || !field.CanSet() {
+		return FieldAccessor{}, fmt.Errorf("windigo: %T has no settable field %s", ptr, name)
+	}
+	return FieldAccessor{
+		Get: func() any { return field.Interface() },
+		Set: func(v any) error {
+			data := reflect.ValueOf(v)
+			if !data.IsValid() {
+				field.SetZero()
+				return nil
+			}
+			if !data.Type().AssignableTo(field.Type()) {
+				// Numbers convert between sizes, but not to text.
+				if !data.Type().ConvertibleTo(field.Type()) || (data.Kind() == reflect.String) != (field.Kind() == reflect.String) {
+					return fmt.Errorf("windigo: cannot assign %T to field %s", v, name)
+				}
+				data = data.Convert(field.Type())
+			}
+			field.Set(data)
+			return nil
+		},
+	}, nil
+}
+
+// Converter translates between field and control values.
+type Converter interface {
+	ToControl(field any) (any, error)
+	FromControl(control any) (any, error)
+}
+
+// identityConverter passes values through unchanged.
+type identityConverter struct{}
+
+func (identityConverter) ToControl(field any) (any, error)     { return field, nil }
+func (identityConverter) FromControl(control any) (any, error) { return control, nil }
+
+// IntConverter shows an int field as text.
+type IntConverter struct{}
+
+func (IntConverter) ToControl(field any) (any, error) {
+	value := reflect.ValueOf(field)
+	if !value.CanInt() {
+		return nil, fmt.Errorf("windigo: %T is not an integer", field)
+	}
+	return strconv.FormatInt(value.Int(), 10), nil
+}
+
+func (IntConverter) FromControl(control any) (any, error) {
+	text, _ := control.(string)
+	return strconv.Atoi(strings.TrimSpace(text))
+}
+
+// FloatConverter shows a float64 field as text, see strconv.FormatFloat.
+// The zero value uses format 'g' with the smallest precision that round trips.
+type FloatConverter struct {
+	Format    byte
+	Precision int
+}
+
+func (conv FloatConverter) ToControl(field any) (any, error) {
+	value := reflect.ValueOf(field)
+	if !value.CanFloat() {
+		return nil, fmt.Errorf("windigo: %T is not a float", field)
+	}
+	format, precision := conv.Format, conv.Precision
+	if format == 0 {
+		format, precision = 'g', -1
+	}
+	return strconv.FormatFloat(value.Float(), format, precision, 64), nil
+}
+
+func (FloatConverter) FromControl(control any) (any, error) {
+	text, _ := control.(string)
+	return strconv.ParseFloat(strings.TrimSpace(text), 64)
+}
+
+// TimeConverter shows a time.Time field as text in Layout.
+type TimeConverter struct {
+	Layout string
+}
+
+func (conv TimeConverter) ToControl(field any) (any, error) {
+	value, ok := field.(time.Time)
+	if !ok {
+		return nil, fmt.Errorf("windigo: %T is not a time.Time", field)
+	}
+	return value.Format(conv.Layout), nil
+}
+
+func (conv TimeConverter) FromControl(control any) (any, error) {
+	text, _ := control.(string)
+	return time.Parse(conv.Layout, strings.TrimSpace(text))
+}
+
+// EnumConverter maps a field to the index of its value in Values,
+// for example the selected item of a ComboBox filled in the same order.
+type EnumConverter struct {
+	Values []any
+}
+
+func (conv EnumConverter) ToControl(field any) (any, error) {
+	for i, value := range conv.Values {
+		if value == field {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+func (conv EnumConverter) FromControl(control any) (any, error) {
+	index, ok := control.(int)
+	if !ok || index < 0 || index >= len(conv.Values) {
+		return nil, errors.New("windigo: no item selected")
+	}
+	return conv.Values[index], nil
+}
+
+// Binding keeps a field and a control in sync.
+// User changes in the control are written to the field as they happen,
+// unless the converter rejects them; see Err.
+type Binding struct {
+	field     FieldAccessor
+	control   ControlAdapter
+	converter Converter
+	group     *BindingGroup
+
+	loaded   any // control value at the last Load or Save
+	dirty    bool
+	err      error
+	updating bool
+
+	// Changed, if set, is called after the user changed the control.
+	Changed func(*Binding)
+}
+
+// NewBinding binds field to control. A nil converter passes values unchanged.
+func NewBinding(field FieldAccessor, control ControlAdapter, converter Converter) *Binding {
+	if converter == nil {
+		converter = identityConverter{}
+	}
+	binding := &Binding{field: field, control: control, converter: converter}
+	control.OnValueChange(binding.controlChanged)
+	return binding
+}
+
+// Load copies the field into the control and clears the dirty flag.
+func (binding *Binding) Load() error {
+	value, err := binding.converter.ToControl(binding.field.Get())
+	if err != nil {
+		return binding.setErr(err)
+	}
+	binding.updating = true
+	binding.control.SetValue(value)
+	binding.updating = false
+
+	binding.loaded = binding.control.Value()
+	binding.setDirty(false)
+	return binding.setErr(nil)
+}
+
+// Save copies the control into the field and clears the dirty flag.
+func (binding *Binding) Save() error {
+	if err := binding.store(); err != nil {
+		return err
+	}
+	binding.loaded = binding.control.Value()
+	binding.setDirty(false)
+	return nil
+}
+
+func (binding *Binding) store() error {
+	value, err := binding.converter.FromControl(binding.control.Value())
+	if err != nil {
+		return binding.setErr(err)
+	}
+	return binding.setErr(binding.field.Set(value))
+}
+
+func (binding *Binding) controlChanged() {
+	if binding.updating {
+		return
+	}
+	binding.store()
+	binding.setDirty(!reflect.DeepEqual(binding.control.Value(), binding.loaded))
+	if binding.Changed != nil {
+		binding.Changed(binding)
+	}
+}
+
+func (binding *Binding) setErr(err error) error {
+	binding.err = err
+	return err
+}
+
+func (binding *Binding) setDirty(dirty bool) {
+	if binding.dirty == dirty {
+		return
+	}
+	binding.dirty = dirty
+	if binding.group != nil {
+		binding.group.dirtyChanged()
+	}
+}
+
+// Dirty reports whether the control changed since the last Load or Save.
+func (binding *Binding) Dirty() bool {
+	return binding.dirty
+}
+
+// Err is the conversion error of the last change, if any.
+func (binding *Binding) Err() error {
+	return binding.err
+}
+
+// BindingGroup holds the bindings of a form.
+type BindingGroup struct {
+	bindings []*Binding
+	dirty    bool
+
+	// DirtyChanged, if set, is called when Dirty changes.
+	DirtyChanged func(dirty bool)
+}
+
+func NewBindingGroup() *BindingGroup {
+	return &BindingGroup{}
+}
+
+// Bind adds a binding of field to control.
+func (group *BindingGroup) Bind(field FieldAccessor, control ControlAdapter, converter Converter) *Binding {
+	binding := NewBinding(field, control, converter)
+	binding.group = group
+	group.bindings = append(group.bindings, binding)
+	return binding
+}
+
+// BindField binds the exported field name of the struct ptr points to.
+func (group *BindingGroup) BindField(ptr any, name string, control ControlAdapter, converter Converter) (*Binding, error) {
+	field, err := FieldOf(ptr, name)
+	if err != nil {
+		return nil, err
+	}
+	return group.Bind(field, control, converter), nil
+}
+
+func (group *BindingGroup) Bindings() []*Binding {
+	return group.bindings
+}
+
+// Load copies every field into its control.
+func (group *BindingGroup) Load() error {
+	var errs []error
+	for _, binding := range group.bindings {
+		if err := binding.Load(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Save copies every control into its field.
+func (group *BindingGroup) Save() error {
+	var errs []error
+	for _, binding := range group.bindings {
+		if err := binding.Save(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Dirty reports whether any control changed since it was loaded or saved.
+func (group *BindingGroup) Dirty() bool {
+	return group.dirty
+}
+
+// DirtyBindings returns the bindings whose control changed.
+func (group *BindingGroup) DirtyBindings() []*Binding {
+	var dirty []*Binding
+	for _, binding := range group.bindings {
+		if binding.dirty {
+			dirty = append(dirty, binding)
+		}
+	}
+	return dirty
+}
+
+func (group *BindingGroup) dirtyChanged() {
+	dirty := len(group.DirtyBindings()) > 0
+	if dirty != group.dirty {
+		group.dirty = dirty
+		if group.DirtyChanged != nil {
+			group.DirtyChanged(dirty)
+		}
+	}
+}
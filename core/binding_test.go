@@ -0,0 +1,212 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeControl is a ControlAdapter that, like the real controls, also
+// notifies when its value is set from code.
+type fakeControl struct {
+	value  any
+	notify func()
+}
+
+func (control *fakeControl) Value() any { return control.value }
+
+func (control *fakeControl) SetValue(value any) {
+	control.value = value
+	control.notify()
+}
+
+func (control *fakeControl) OnValueChange(notify func()) { control.notify = notify }
+
+// edit is the user changing the value.
+func (control *fakeControl) edit(value any) {
+	control.value = value
+	control.notify()
+}
+
+type person struct {
+	Name   string
+	Age    int
+	Weight float64
+	secret string
+}
+
+func TestBindingLoadEditSave(t *testing.T) {
+	data := &person{Name: "Ann", Age: 30}
+	name, age := &fakeControl{}, &fakeControl{}
+	group := NewBindingGroup()
+	var dirtyChanges []bool
+	group.DirtyChanged = func(dirty bool) { dirtyChanges = append(dirtyChanges, dirty) }
+	nameBinding, err := group.BindField(data, "Name", name, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ageBinding, err := group.BindField(data, "Age", age, IntConverter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := 0
+	ageBinding.Changed = func(*Binding) { changes++ }
+
+	if err := group.Load(); err != nil {
+		t.Fatal(err)
+	}
+	if name.value != "Ann" || age.value != "30" {
+		t.Errorf("controls = %v, %v after Load", name.value, age.value)
+	}
+	if group.Dirty() || changes != 0 {
+		t.Errorf("Load made the group dirty %v or fired %d changes", group.Dirty(), changes)
+	}
+
+	age.edit("31")
+	if data.Age != 31 || !ageBinding.Dirty() || !group.Dirty() || changes != 1 {
+		t.Errorf("after edit: age %d, dirty %v, group dirty %v, changes %d", data.Age, ageBinding.Dirty(), group.Dirty(), changes)
+	}
+	if got := group.DirtyBindings(); len(got) != 1 || got[0] != ageBinding {
+		t.Errorf("DirtyBindings() = %v, want the age binding", got)
+	}
+
+	age.edit("x")
+	if ageBinding.Err() == nil || data.Age != 31 {
+		t.Errorf("bad text: err %v, age %d, want an error and the field unchanged", ageBinding.Err(), data.Age)
+	}
+	age.edit("30")
+	if ageBinding.Err() != nil || ageBinding.Dirty() || group.Dirty() {
+		t.Errorf("back to the loaded value: err %v, dirty %v, group dirty %v", ageBinding.Err(), ageBinding.Dirty(), group.Dirty())
+	}
+
+	name.edit("Bob")
+	if err := group.Save(); err != nil || nameBinding.Dirty() || group.Dirty() || data.Name != "Bob" {
+		t.Errorf("Save() = %v, dirty %v, group dirty %v, name %q", err, nameBinding.Dirty(), group.Dirty(), data.Name)
+	}
+	if want := []bool{true, false, true, false}; !reflect.DeepEqual(dirtyChanges, want) {
+		t.Errorf("DirtyChanged calls %v, want %v", dirtyChanges, want)
+	}
+}
+
+func TestBindingGroupLoadErrors(t *testing.T) {
+	group := NewBindingGroup()
+	group.Bind(Accessor(func() string { return "x" }, func(string) {}), &fakeControl{}, IntConverter{})
+	group.Bind(Accessor(func() int { return 1 }, func(int) {}), &fakeControl{}, IntConverter{})
+	group.Bind(Accessor(func() bool { return true }, func(bool) {}), &fakeControl{}, FloatConverter{})
+	err := group.Load()
+	if err == nil || !strings.Contains(err.Error(), "string is not an integer") || !strings.Contains(err.Error(), "bool is not a float") {
+		t.Errorf("Load() = %v, want both conversion errors", err)
+	}
+}
+
+func TestAccessor(t *testing.T) {
+	value := 0
+	field := Accessor(func() int { return value }, func(v int) { value = v })
+	if err := field.Set(5); err != nil || value != 5 || field.Get() != 5 {
+		t.Errorf("Set(5) = %v, value %d", err, value)
+	}
+	if err := field.Set("5"); err == nil {
+		t.Error("Set(\"5\") on an int accessor succeeded")
+	}
+}
+
+func TestFieldOf(t *testing.T) {
+	data := &person{}
+	for _, test := range []struct {
+		name  string
+		ptr   any
+		field string
+	}{
+		{"not a pointer", person{}, "Name"},
+		{"not a struct", new(int), "Name"},
+		{"missing field", data, "Height"},
+		{"unexported field", data, "secret"},
+	} {
+		if _, err := FieldOf(test.ptr, test.field); err == nil {
+			t.Errorf("%s: FieldOf succeeded", test.name)
+		}
+	}
+
+	tests := []struct {
+		field string
+		value any
+		want  any
+		fails bool
+	}{
+		{"Name", "Ann", "Ann", false},
+		{"Age", 7, 7, false},
+		{"Age", int64(8), 8, false},
+		{"Weight", 3, 3.0, false},
+		{"Age", "9", nil, true},
+		{"Name", 65, nil, true},
+		{"Age", nil, 0, false},
+	}
+	for _, test := range tests {
+		field, err := FieldOf(data, test.field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = field.Set(test.value)
+		if test.fails {
+			if err == nil {
+				t.Errorf("Set(%#v) on %s succeeded", test.value, test.field)
+			}
+			continue
+		}
+		if err != nil || field.Get() != test.want {
+			t.Errorf("Set(%#v) on %s = %v, field %#v, want %#v", test.value, test.field, err, field.Get(), test.want)
+		}
+	}
+}
+
+func TestConverters(t *testing.T) {
+	date := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		converter Converter
+		field     any
+		control   any
+	}{
+		{"int", IntConverter{}, 42, "42"},
+		{"float", FloatConverter{}, 0.1, "0.1"},
+		{"float fixed", FloatConverter{Format: 'f', Precision: 2}, 1.5, "1.50"},
+		{"time", TimeConverter{Layout: "2006-01-02"}, date, "2024-02-29"},
+		{"enum", EnumConverter{Values: []any{"red", "green"}}, "green", 1},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			control, err := test.converter.ToControl(test.field)
+			if err != nil || control != test.control {
+				t.Errorf("ToControl(%v) = %#v, %v, want %#v", test.field, control, err, test.control)
+			}
+			field, err := test.converter.FromControl(test.control)
+			if err != nil || !reflect.DeepEqual(field, test.field) {
+				t.Errorf("FromControl(%#v) = %#v, %v, want %#v", test.control, field, err, test.field)
+			}
+		})
+	}
+
+	for _, test := range []struct {
+		name      string
+		converter Converter
+		control   any
+	}{
+		{"int", IntConverter{}, "4x"},
+		{"float", FloatConverter{}, "four"},
+		{"time", TimeConverter{Layout: "2006-01-02"}, "29/02/2024"},
+		{"enum none selected", EnumConverter{Values: []any{"red"}}, -1},
+		{"enum out of range", EnumConverter{Values: []any{"red"}}, 1},
+	} {
+		if _, err := test.converter.FromControl(test.control); err == nil {
+			t.Errorf("%s: FromControl(%#v) succeeded", test.name, test.control)
+		}
+	}
+	if index, err := (EnumConverter{Values: []any{"red"}}).ToControl("blue"); err != nil || index != -1 {
+		t.Errorf("EnumConverter.ToControl of an unknown value = %v, %v, want -1", index, err)
+	}
+}
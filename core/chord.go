@@ -0,0 +1,183 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Chord is a sequence of shortcuts pressed one after the other, like Ctrl+K, Ctrl+C.
+type Chord []Shortcut
+
+func (chord Chord) String() string {
+	names := make([]string, len(chord))
+	for i, shortcut := range chord {
+		names[i] = shortcut.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// ParseChord parses shortcuts separated by commas, as written by Chord.String.
+// A comma after + is the comma key, as in "Ctrl+K, Ctrl+,".
+func ParseChord(text string) (Chord, error) {
+	var chord Chord
+	start := 0
+	for i, char := range text {
+		if char != ',' {
+			continue
+		}
+		// A comma is the key if the shortcut has nothing else after its last +.
+		if part := strings.TrimSpace(text[start:i]); part == "" || strings.HasSuffix(part, "+") {
+			continue
+		}
+		shortcut, err := ParseShortcut(text[start:i])
+		if err != nil {
+			return nil, err
+		}
+		chord = append(chord, shortcut)
+		start = i + 1
+	}
+	shortcut, err := ParseShortcut(text[start:])
+	if err != nil {
+		return nil, err
+	}
+	if shortcut == (Shortcut{}) {
+		return nil, fmt.Errorf("chord %q ends without a shortcut", text)
+	}
+	return append(chord, shortcut), nil
+}
+
+// ChordResult is what a ChordMatcher made of a key press.
+type ChordResult int
+
+const (
+	ChordNone           ChordResult = iota // no chord starts with the key, it is not used
+	ChordPending                           // the keys pressed start a chord, the next key is awaited
+	ChordMatched                           // the keys pressed are a chord
+	ChordCanceled                          // the pending keys were canceled by Escape or a key no chord continues with
+	ChordMatchedPending                    // the pending keys are a chord the key does not continue or that timed out, the key is not used
+)
+
+// ChordMatcher follows the keys pressed to find chords. It is driven by Press
+// and Expire, which take the time to keep it independent of the clock.
+type ChordMatcher struct {
+	Timeout time.Duration  // time allowed between keys, 0 waits forever
+	Extra   func() []Chord // more chords to match, asked on every key press so they may change
+
+	chords  []Chord
+	pending Chord
+	last    time.Time
+}
+
+func NewChordMatcher(timeout time.Duration) *ChordMatcher {
+	return &ChordMatcher{Timeout: timeout}
+}
+
+// Add a chord to match. A chord that starts a longer one stays pending until
+// the next key or the timeout tells them apart.
+func (matcher *ChordMatcher) Add(chord Chord) {
+	if len(chord) > 0 && !slices.ContainsFunc(matcher.chords, chord.equal) {
+		matcher.chords = append(matcher.chords, slices.Clone(chord))
+	}
+}
+
+func (matcher *ChordMatcher) Remove(chord Chord) {
+	matcher.chords = slices.DeleteFunc(matcher.chords, chord.equal)
+}
+
+func (chord Chord) equal(other Chord) bool {
+	return slices.Equal(chord, other)
+}
+
+// Pending returns the keys pressed so far of a chord, nil if none is pending.
+func (matcher *ChordMatcher) Pending() Chord {
+	return slices.Clone(matcher.pending)
+}
+
+// Cancel the pending keys.
+func (matcher *ChordMatcher) Cancel() {
+	matcher.pending = nil
+}
+
+// Expire ends the pending keys if the timeout passed since the last one.
+// It returns ChordMatched and the chord if they are one, ChordCanceled if not,
+// and ChordNone if nothing expired.
+func (matcher *ChordMatcher) Expire(now time.Time) (ChordResult, Chord) {
+	if len(matcher.pending) == 0 || matcher.Timeout == 0 || now.Sub(matcher.last) < matcher.Timeout {
+		return ChordNone, nil
+	}
+	return matcher.end()
+}
+
+// end drops the pending keys, matching them if they are a chord.
+func (matcher *ChordMatcher) end() (ChordResult, Chord) {
+	chord, _ := matcher.find(matcher.pending)
+	matcher.pending = nil
+	if chord != nil {
+		return ChordMatched, chord
+	}
+	return ChordCanceled, nil
+}
+
+// find returns the chord that keys are, and whether keys start a longer chord.
+func (matcher *ChordMatcher) find(keys Chord) (match Chord, prefix bool) {
+	chords := matcher.chords
+	if matcher.Extra != nil {
+		chords = append(slices.Clip(chords), matcher.Extra()...)
+	}
+	for _, chord := range chords {
+		if match == nil && chord.equal(keys) {
+			match = chord
+		}
+		prefix = prefix || len(chord) > len(keys) && chord[:len(keys)].equal(keys)
+	}
+	return match, prefix
+}
+
+// Press feeds a key pressed at now. For ChordMatched and ChordMatchedPending it returns the chord.
+// Modifier keys on their own are ignored, Escape cancels the pending keys.
+func (matcher *ChordMatcher) Press(shortcut Shortcut, now time.Time) (ChordResult, Chord) {
+	if result, chord := matcher.Expire(now); result == ChordMatched {
+		return ChordMatchedPending, chord
+	}
+	if isModifierKey(shortcut.Key) {
+		if len(matcher.pending) > 0 {
+			return ChordPending, nil
+		}
+		return ChordNone, nil
+	}
+	if len(matcher.pending) > 0 && shortcut == (Shortcut{Key: KeyEscape}) {
+		matcher.pending = nil
+		return ChordCanceled, nil
+	}
+
+	keys := append(slices.Clone(matcher.pending), shortcut)
+	match, prefix := matcher.find(keys)
+	switch {
+	case prefix:
+		matcher.pending, matcher.last = keys, now
+		return ChordPending, nil
+	case match != nil:
+		matcher.pending = nil
+		return ChordMatched, match
+	case len(matcher.pending) > 0:
+		if result, chord := matcher.end(); result == ChordMatched {
+			return ChordMatchedPending, chord
+		}
+		return ChordCanceled, nil
+	}
+	return ChordNone, nil
+}
+
+func isModifierKey(key Key) bool {
+	switch key {
+	case KeyShift, KeyControl, KeyAlt, KeyLShift, KeyRShift, KeyLControl, KeyRControl, KeyLMenu, KeyRMenu, KeyLWIN, KeyRWIN:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+func mustChord(t *testing.T, text string) Chord {
+	t.Helper()
+	chord, err := ParseChord(text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chord
+}
+
+func TestParseChord(t *testing.T) {
+	tests := []struct {
+		text string
+		want string // Chord.String, or "" for an error
+	}{
+		{"Ctrl+K, Ctrl+C", "Ctrl+K, Ctrl+C"},
+		{"ctrl+k,ctrl+c", "Ctrl+K, Ctrl+C"},
+		{"F5", "F5"},
+		{"Ctrl+K, Ctrl+,", "Ctrl+K, Ctrl+OEMComma"},
+		{"Ctrl+,, A", "Ctrl+OEMComma, A"},
+		{",", "OEMComma"},
+		{"Ctrl+K, ,", "Ctrl+K, OEMComma"},
+		{"G, G, G", "G, G, G"},
+		{"", ""},
+		{"Ctrl+K,", ""},
+		{"Ctrl+K, Foo", ""},
+		{"Hyper+K, A", ""},
+	}
+	for _, test := range tests {
+		chord, err := ParseChord(test.text)
+		switch {
+		case test.want == "" && err == nil:
+			t.Errorf("ParseChord(%q) = %q, want an error", test.text, chord)
+		case test.want != "" && err != nil:
+			t.Errorf("ParseChord(%q): %v", test.text, err)
+		case test.want != "" && chord.String() != test.want:
+			t.Errorf("ParseChord(%q) = %q, want %q", test.text, chord, test.want)
+		}
+		if err == nil {
+			if again, err := ParseChord(chord.String()); err != nil || again.String() != chord.String() {
+				t.Errorf("ParseChord(%q) = %q, %v, want the chord back", chord, again, err)
+			}
+		}
+	}
+}
+
+func TestChordMatcher(t *testing.T) {
+	// A step presses key at ms, or expires at ms if key is "".
+	type step struct {
+		key     string
+		ms      int
+		result  ChordResult
+		chord   string
+		pending string
+	}
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		steps   []step
+	}{
+		{"single shortcut", time.Second, []step{
+			{"F5", 0, ChordMatched, "F5", ""},
+		}},
+		{"unknown key", time.Second, []step{
+			{"A", 0, ChordNone, "", ""},
+		}},
+		{"two keys", time.Second, []step{
+			{"Ctrl+K", 0, ChordPending, "", "Ctrl+K"},
+			{"Ctrl+C", 100, ChordMatched, "Ctrl+K, Ctrl+C", ""},
+		}},
+		{"three keys", time.Second, []step{
+			{"G", 0, ChordPending, "", "G"},
+			{"G", 100, ChordPending, "", "G, G"},
+			{"G", 200, ChordMatched, "G, G, G", ""},
+		}},
+		{"prefix that is a chord, ended by another key", time.Second, []step{
+			{"Ctrl+K", 0, ChordPending, "", "Ctrl+K"},
+			{"A", 100, ChordMatchedPending, "Ctrl+K", ""},
+			{"A", 200, ChordNone, "", ""},
+		}},
+		{"prefix that is no chord, ended by another key", time.Second, []step{
+			{"G", 0, ChordPending, "", "G"},
+			{"A", 100, ChordCanceled, "", ""},
+		}},
+		{"escape", time.Second, []step{
+			{"Ctrl+K", 0, ChordPending, "", "Ctrl+K"},
+			{"Esc", 100, ChordCanceled, "", ""},
+			{"Esc", 200, ChordNone, "", ""},
+		}},
+		{"modifier keys", time.Second, []step{
+			{"Ctrl+Control", 0, ChordNone, "", ""},
+			{"Ctrl+K", 100, ChordPending, "", "Ctrl+K"},
+			{"Ctrl+Control", 200, ChordPending, "", "Ctrl+K"},
+			{"Ctrl+Shift+LShift", 300, ChordPending, "", "Ctrl+K"},
+			{"Ctrl+U", 400, ChordMatched, "Ctrl+K, Ctrl+U", ""},
+		}},
+		{"expire", time.Second, []step{
+			{"Ctrl+K", 0, ChordPending, "", "Ctrl+K"},
+			{"", 999, ChordNone, "", "Ctrl+K"},
+			{"", 1000, ChordMatched, "Ctrl+K", ""},
+			{"", 2000, ChordNone, "", ""},
+		}},
+		{"expire without a chord", time.Second, []step{
+			{"G", 0, ChordPending, "", "G"},
+			{"", 1500, ChordCanceled, "", ""},
+		}},
+		{"timeout counts from the last key", time.Second, []step{
+			{"G", 0, ChordPending, "", "G"},
+			{"G", 900, ChordPending, "", "G, G"},
+			{"", 1500, ChordNone, "", "G, G"},
+			{"G", 1800, ChordMatched, "G, G, G", ""},
+		}},
+		{"key after the timeout", time.Second, []step{
+			{"Ctrl+K", 0, ChordPending, "", "Ctrl+K"},
+			{"Ctrl+C", 1500, ChordMatchedPending, "Ctrl+K", ""},
+		}},
+		{"key after the timeout of no chord", time.Second, []step{
+			{"G", 0, ChordPending, "", "G"},
+			{"F5", 1500, ChordMatched, "F5", ""},
+		}},
+		{"no timeout", 0, []step{
+			{"Ctrl+K", 0, ChordPending, "", "Ctrl+K"},
+			{"", 3600000, ChordNone, "", "Ctrl+K"},
+			{"Ctrl+C", 3600000, ChordMatched, "Ctrl+K, Ctrl+C", ""},
+		}},
+		{"extra chords", time.Second, []step{
+			{"Ctrl+E", 0, ChordPending, "", "Ctrl+E"},
+			{"E", 100, ChordMatched, "Ctrl+E, E", ""},
+		}},
+	}
+	for _, test := range tests {
+		matcher := NewChordMatcher(test.timeout)
+		for _, chord := range []string{"Ctrl+K, Ctrl+C", "Ctrl+K, Ctrl+U", "Ctrl+K", "F5", "G, G, G"} {
+			matcher.Add(mustChord(t, chord))
+		}
+		matcher.Extra = func() []Chord { return []Chord{mustChord(t, "Ctrl+E, E")} }
+
+		start := time.Now()
+		for i, step := range test.steps {
+			now := start.Add(time.Duration(step.ms) * time.Millisecond)
+			var result ChordResult
+			var chord Chord
+			if step.key == "" {
+				result, chord = matcher.Expire(now)
+			} else {
+				shortcut, err := ParseShortcut(step.key)
+				if err != nil {
+					t.Fatal(err)
+				}
+				result, chord = matcher.Press(shortcut, now)
+			}
+			if result != step.result || chord.String() != step.chord {
+				t.Errorf("%s, step %d: %d %q, want %d %q", test.name, i, result, chord, step.result, step.chord)
+			}
+			if got := matcher.Pending().String(); got != step.pending {
+				t.Errorf("%s, step %d: pending %q, want %q", test.name, i, got, step.pending)
+			}
+		}
+	}
+}
+
+func TestChordMatcherAddRemove(t *testing.T) {
+	matcher := NewChordMatcher(0)
+	chord := mustChord(t, "Ctrl+K, Ctrl+C")
+	matcher.Add(chord)
+	matcher.Add(mustChord(t, "Ctrl+K, Ctrl+C"))
+	matcher.Add(nil)
+
+	// Changing the chord passed to Add does not change the matcher.
+	chord[1] = Shortcut{ModControl, KeyX}
+	if result, _ := matcher.Press(Shortcut{ModControl, KeyK}, time.Now()); result != ChordPending {
+		t.Fatalf("Press(Ctrl+K) = %d, want ChordPending", result)
+	}
+	if result, got := matcher.Press(Shortcut{ModControl, KeyC}, time.Now()); result != ChordMatched || got.String() != "Ctrl+K, Ctrl+C" {
+		t.Fatalf("Press(Ctrl+C) = %d %q, want ChordMatched", result, got)
+	}
+
+	matcher.Remove(mustChord(t, "Ctrl+K, Ctrl+C"))
+	if result, _ := matcher.Press(Shortcut{ModControl, KeyK}, time.Now()); result != ChordNone {
+		t.Errorf("Press(Ctrl+K) after Remove = %d, want ChordNone, the duplicate was not added", result)
+	}
+
+	matcher.Add(mustChord(t, "G, G"))
+	matcher.Press(Shortcut{Key: KeyG}, time.Now())
+	matcher.Cancel()
+	if pending := matcher.Pending(); pending != nil {
+		t.Errorf("Pending after Cancel = %q", pending)
+	}
+}
@@ -0,0 +1,34 @@
+/*
+ * Copyright (C) 2019 The windigo Authors. All Rights Reserved.
+ * Copyright (C) 2010-2013 Allen Dang. All Rights Reserved.
+ */
+
+package core
+
+// Color is a COLORREF: red in the low byte, then green and blue.
+type Color uint32
+
+func RGB(r, g, b byte) Color {
+	return Color(uint32(r) | uint32(g)<<8 | uint32(b)<<16)
+}
+
+func (c Color) R() byte {
+	return byte(c & 0xff)
+}
+
+func (c Color) G() byte {
+	return byte((c >> 8) & 0xff)
+}
+
+func (c Color) B() byte {
+	return byte((c >> 16) & 0xff)
+}
+
+// Font style bits.
+const (
+	FontNormal    byte = 0x00
+	FontBold      byte = 0x01
+	FontItalic    byte = 0x02
+	FontUnderline byte = 0x04
+	FontStrikeOut byte = 0x08
+)
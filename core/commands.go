@@ -0,0 +1,282 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Command is an action that menus, tool buttons and shortcuts run, see Commands.
+type Command struct {
+	ID       string
+	Title    string
+	Shortcut Shortcut    // default shortcut, the user can rebind it
+	Chord    Chord       // keys pressed one after the other that also run it
+	Enabled  func() bool // nil is always enabled
+	Run      func()
+
+	// Handle runs instead of Run and reports whether the command used the key,
+	// false leaves it to the focused control.
+	Handle func() bool
+}
+
+func (cmd *Command) IsEnabled() bool {
+	return cmd.Enabled == nil || cmd.Enabled()
+}
+
+// Commands is a registry of commands. The shortcut of a command is its default,
+// unless the user rebound it; the rebindings can be saved and loaded.
+type Commands struct {
+	commands  map[string]*Command
+	ids       []string // in registration order
+	overrides map[string]Shortcut
+
+	// Changed is called with the ID of a command whose shortcut may have changed.
+	Changed func(id string)
+}
+
+// CommandBinding is a command and its shortcut, see Commands.Bindings.
+type CommandBinding struct {
+	Command  *Command
+	Shortcut Shortcut
+	Rebound  bool // Shortcut is not the default of the command
+}
+
+// ShortcutConflict is a shortcut bound to several commands.
+type ShortcutConflict struct {
+	Shortcut Shortcut
+	Commands []*Command
+}
+
+func NewCommands() *Commands {
+	return &Commands{
+		commands:  make(map[string]*Command),
+		overrides: make(map[string]Shortcut),
+	}
+}
+
+// Register adds cmd. IDs must be unique.
+func (commands *Commands) Register(cmd Command) (*Command, error) {
+	if cmd.ID == "" {
+		return nil, fmt.Errorf("command %q has no ID", cmd.Title)
+	}
+	if _, ok := commands.commands[cmd.ID]; ok {
+		return nil, fmt.Errorf("command %q is already registered", cmd.ID)
+	}
+	commands.commands[cmd.ID] = &cmd
+	commands.ids = append(commands.ids, cmd.ID)
+	return &cmd, nil
+}
+
+// Command returns the command id, or nil.
+func (commands *Commands) Command(id string) *Command {
+	return commands.commands[id]
+}
+
+// All returns the commands in registration order.
+func (commands *Commands) All() []*Command {
+	all := make([]*Command, len(commands.ids))
+	for i, id := range commands.ids {
+		all[i] = commands.commands[id]
+	}
+	return all
+}
+
+// Shortcut returns the shortcut of command id, the zero Shortcut if it has none.
+func (commands *Commands) Shortcut(id string) Shortcut {
+	if shortcut, ok := commands.overrides[id]; ok {
+		return shortcut
+	}
+	if cmd := commands.commands[id]; cmd != nil {
+		return cmd.Shortcut
+	}
+	return Shortcut{}
+}
+
+// Rebound reports whether the shortcut of command id is not its default.
+func (commands *Commands) Rebound(id string) bool {
+	cmd := commands.commands[id]
+	shortcut, ok := commands.overrides[id]
+	return ok && (cmd == nil || shortcut != cmd.Shortcut)
+}
+
+// SetShortcut rebinds command id. The zero Shortcut unbinds it. It does not check
+// for conflicts, see Conflicting.
+func (commands *Commands) SetShortcut(id string, shortcut Shortcut) error {
+	cmd := commands.commands[id]
+	if cmd == nil {
+		return fmt.Errorf("unknown command %q", id)
+	}
+	if shortcut == cmd.Shortcut {
+		delete(commands.overrides, id)
+	} else {
+		commands.overrides[id] = shortcut
+	}
+	commands.changed(id)
+	return nil
+}
+
+// ResetShortcuts gives all commands their default shortcuts.
+func (commands *Commands) ResetShortcuts() {
+	overrides := commands.overrides
+	commands.overrides = make(map[string]Shortcut)
+	for id := range overrides {
+		commands.changed(id)
+	}
+}
+
+// Lookup returns the commands bound to shortcut, in registration order.
+func (commands *Commands) Lookup(shortcut Shortcut) []*Command {
+	if shortcut == (Shortcut{}) {
+		return nil
+	}
+	var found []*Command
+	for _, id := range commands.ids {
+		if commands.Shortcut(id) == shortcut {
+			found = append(found, commands.commands[id])
+		}
+	}
+	return found
+}
+
+// Conflicting returns the commands other than id that are bound to shortcut,
+// to warn the user before SetShortcut.
+func (commands *Commands) Conflicting(id string, shortcut Shortcut) []*Command {
+	var found []*Command
+	for _, cmd := range commands.Lookup(shortcut) {
+		if cmd.ID != id {
+			found = append(found, cmd)
+		}
+	}
+	return found
+}
+
+// Conflicts returns the shortcuts bound to several commands, in registration order.
+// A conflicting shortcut runs the first of its commands that is enabled.
+func (commands *Commands) Conflicts() []ShortcutConflict {
+	var conflicts []ShortcutConflict
+	seen := make(map[Shortcut]bool)
+	for _, id := range commands.ids {
+		shortcut := commands.Shortcut(id)
+		if shortcut == (Shortcut{}) || seen[shortcut] {
+			continue
+		}
+		seen[shortcut] = true
+		if bound := commands.Lookup(shortcut); len(bound) > 1 {
+			conflicts = append(conflicts, ShortcutConflict{shortcut, bound})
+		}
+	}
+	return conflicts
+}
+
+// Bindings returns every command with its shortcut in registration order, for a shortcut help screen.
+func (commands *Commands) Bindings() []CommandBinding {
+	bindings := make([]CommandBinding, len(commands.ids))
+	for i, id := range commands.ids {
+		bindings[i] = CommandBinding{commands.commands[id], commands.Shortcut(id), commands.Rebound(id)}
+	}
+	return bindings
+}
+
+// Run runs command id if it is enabled and reports whether it ran,
+// or what Handle returned.
+func (commands *Commands) Run(id string) bool {
+	cmd := commands.commands[id]
+	if cmd == nil || !cmd.IsEnabled() {
+		return false
+	}
+	if cmd.Handle != nil {
+		return cmd.Handle()
+	}
+	if cmd.Run == nil {
+		return false
+	}
+	cmd.Run()
+	return true
+}
+
+// RunShortcut runs the first enabled command bound to shortcut and reports whether one ran.
+func (commands *Commands) RunShortcut(shortcut Shortcut) bool {
+	for _, cmd := range commands.Lookup(shortcut) {
+		if commands.Run(cmd.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Chords returns the shortcuts and chords of the commands, as chords.
+func (commands *Commands) Chords() []Chord {
+	var chords []Chord
+	for _, id := range commands.ids {
+		if shortcut := commands.Shortcut(id); shortcut != (Shortcut{}) {
+			chords = append(chords, Chord{shortcut})
+		}
+		if chord := commands.commands[id].Chord; len(chord) > 0 {
+			chords = append(chords, chord)
+		}
+	}
+	return chords
+}
+
+// RunChord runs the first enabled command with chord, or bound to it if it is
+// a single shortcut, and reports whether one ran.
+func (commands *Commands) RunChord(chord Chord) bool {
+	if len(chord) == 1 && commands.RunShortcut(chord[0]) {
+		return true
+	}
+	for _, id := range commands.ids {
+		if slices.Equal(commands.commands[id].Chord, chord) && commands.Run(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveShortcuts writes the rebound shortcuts as JSON, by command ID,
+// with "" for unbound commands.
+func (commands *Commands) SaveShortcuts(w io.Writer) error {
+	saved := make(map[string]string, len(commands.overrides))
+	for id, shortcut := range commands.overrides {
+		saved[id] = shortcut.String()
+	}
+	return json.NewEncoder(w).Encode(saved)
+}
+
+// LoadShortcuts replaces the rebound shortcuts with those written by SaveShortcuts.
+// Shortcuts of commands registered later are kept for them. Nothing changes on error.
+func (commands *Commands) LoadShortcuts(r io.Reader) error {
+	var saved map[string]string
+	if err := json.NewDecoder(r).Decode(&saved); err != nil {
+		return err
+	}
+	overrides := make(map[string]Shortcut, len(saved))
+	for id, text := range saved {
+		shortcut, err := ParseShortcut(text)
+		if err != nil {
+			return fmt.Errorf("command %q: %w", id, err)
+		}
+		overrides[id] = shortcut
+	}
+
+	old := commands.overrides
+	commands.overrides = overrides
+	for id := range old {
+		commands.changed(id)
+	}
+	for id := range overrides {
+		commands.changed(id)
+	}
+	return nil
+}
+
+func (commands *Commands) changed(id string) {
+	if commands.Changed != nil {
+		commands.Changed(id)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+var (
+	ctrlS = Shortcut{Modifiers: ModControl, Key: KeyS}
+	ctrlO = Shortcut{Modifiers: ModControl, Key: KeyO}
+	ctrlP = Shortcut{Modifiers: ModControl, Key: KeyP}
+)
+
+// newTestCommands registers save on Ctrl+S, open on Ctrl+O and print without a shortcut.
+func newTestCommands(t *testing.T) *Commands {
+	t.Helper()
+	commands := NewCommands()
+	for _, cmd := range []Command{
+		{ID: "save", Shortcut: ctrlS},
+		{ID: "open", Shortcut: ctrlO},
+		{ID: "print"},
+	} {
+		if _, err := commands.Register(cmd); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return commands
+}
+
+func commandIDs(cmds []*Command) string {
+	ids := make([]string, len(cmds))
+	for i, cmd := range cmds {
+		ids[i] = cmd.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestCommandsRegister(t *testing.T) {
+	commands := newTestCommands(t)
+	if _, err := commands.Register(Command{ID: "save"}); err == nil {
+		t.Error("Register a duplicate ID: no error")
+	}
+	if _, err := commands.Register(Command{Title: "No ID"}); err == nil {
+		t.Error("Register without an ID: no error")
+	}
+	if got := commandIDs(commands.All()); got != "save,open,print" {
+		t.Errorf("All = %s, want save,open,print", got)
+	}
+	if got := commandIDs(commands.Lookup(ctrlS)); got != "save" {
+		t.Errorf("Lookup(Ctrl+S) = %s, want save", got)
+	}
+	if got := commands.Lookup(Shortcut{}); got != nil {
+		t.Errorf("Lookup of no shortcut = %s, want none", commandIDs(got))
+	}
+}
+
+func TestCommandsConflicts(t *testing.T) {
+	commands := newTestCommands(t)
+	if conflicts := commands.Conflicts(); len(conflicts) != 0 {
+		t.Fatalf("Conflicts = %v, want none", conflicts)
+	}
+	if got := commandIDs(commands.Conflicting("print", ctrlS)); got != "save" {
+		t.Errorf("Conflicting(print, Ctrl+S) = %s, want save", got)
+	}
+	if got := commands.Conflicting("save", ctrlS); len(got) != 0 {
+		t.Errorf("Conflicting(save, Ctrl+S) = %s, want none", commandIDs(got))
+	}
+
+	commands.SetShortcut("print", ctrlS)
+	commands.SetShortcut("open", ctrlS)
+	conflicts := commands.Conflicts()
+	if len(conflicts) != 1 || conflicts[0].Shortcut != ctrlS || commandIDs(conflicts[0].Commands) != "save,open,print" {
+		t.Fatalf("Conflicts = %v, want Ctrl+S bound to save,open,print", conflicts)
+	}
+
+	// The first enabled command runs.
+	var ran string
+	commands.Command("save").Enabled = func() bool { return false }
+	commands.Command("open").Run = func() { ran = "open" }
+	commands.Command("print").Run = func() { ran = "print" }
+	if !commands.RunShortcut(ctrlS) || ran != "open" {
+		t.Errorf("RunShortcut(Ctrl+S) ran %q, want open", ran)
+	}
+}
+
+func TestCommandsRunHandle(t *testing.T) {
+	commands := NewCommands()
+	var ran []string
+	commands.Register(Command{ID: "pass", Shortcut: ctrlS, Handle: func() bool { ran = append(ran, "pass"); return false }})
+	commands.Register(Command{ID: "save", Shortcut: ctrlS, Run: func() { ran = append(ran, "save") }})
+	if !commands.RunShortcut(ctrlS) || strings.Join(ran, ",") != "pass,save" {
+		t.Errorf("RunShortcut(Ctrl+S) ran %v, want pass,save", ran)
+	}
+	if commands.Run("pass") {
+		t.Error("Run of a command whose Handle returns false = true")
+	}
+}
+
+func TestCommandsRebind(t *testing.T) {
+	commands := newTestCommands(t)
+	var changed []string
+	commands.Changed = func(id string) { changed = append(changed, id) }
+
+	if err := commands.SetShortcut("save", ctrlP); err != nil {
+		t.Fatal(err)
+	}
+	if commands.Shortcut("save") != ctrlP || !commands.Rebound("save") {
+		t.Errorf("after rebinding save: Shortcut = %v, Rebound = %v, want Ctrl+P rebound", commands.Shortcut("save"), commands.Rebound("save"))
+	}
+	if got := commands.Lookup(ctrlS); len(got) != 0 {
+		t.Errorf("Lookup(Ctrl+S) after rebinding = %s, want none", commandIDs(got))
+	}
+
+	// Rebinding to the default is no rebinding.
+	commands.SetShortcut("save", ctrlS)
+	if commands.Shortcut("save") != ctrlS || commands.Rebound("save") {
+		t.Errorf("after rebinding back: Shortcut = %v, Rebound = %v, want Ctrl+S not rebound", commands.Shortcut("save"), commands.Rebound("save"))
+	}
+
+	// Unbinding.
+	commands.SetShortcut("open", Shortcut{})
+	if commands.Shortcut("open") != (Shortcut{}) || !commands.Rebound("open") {
+		t.Errorf("after unbinding open: Shortcut = %v, Rebound = %v", commands.Shortcut("open"), commands.Rebound("open"))
+	}
+
+	commands.ResetShortcuts()
+	if commands.Shortcut("open") != ctrlO || commands.Rebound("open") {
+		t.Errorf("after ResetShortcuts: Shortcut = %v, want Ctrl+O", commands.Shortcut("open"))
+	}
+	if got := strings.Join(changed, ","); got != "save,save,open,open" {
+		t.Errorf("Changed for %s, want save,save,open,open", got)
+	}
+	if err := commands.SetShortcut("unknown", ctrlP); err == nil {
+		t.Error("SetShortcut of an unknown command: no error")
+	}
+}
+
+func TestCommandsSaveLoad(t *testing.T) {
+	commands := newTestCommands(t)
+	commands.SetShortcut("save", ctrlP)
+	commands.SetShortcut("open", Shortcut{})
+	commands.SetShortcut("print", Shortcut{Modifiers: ModControl | ModShift, Key: KeyOEMPlus})
+	var buf bytes.Buffer
+	if err := commands.SaveShortcuts(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	// Shortcuts of commands not registered yet are kept for them.
+	loaded := NewCommands()
+	loaded.Register(Command{ID: "save", Shortcut: ctrlS})
+	if err := loaded.LoadShortcuts(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	loaded.Register(Command{ID: "open", Shortcut: ctrlO})
+	loaded.Register(Command{ID: "print"})
+	for _, id := range []string{"save", "open", "print"} {
+		if got, want := loaded.Shortcut(id), commands.Shortcut(id); got != want || !loaded.Rebound(id) {
+			t.Errorf("loaded Shortcut(%s) = %v, rebound %v, want %v rebound", id, got, loaded.Rebound(id), want)
+		}
+	}
+
+	// Loading replaces the rebindings, and nothing changes on error.
+	if err := loaded.LoadShortcuts(strings.NewReader(`{"save":"Ctrl+Nope"}`)); err == nil {
+		t.Error("LoadShortcuts of a bad shortcut: no error")
+	}
+	if loaded.Shortcut("save") != ctrlP {
+		t.Errorf("Shortcut(save) after a failed load = %v, want Ctrl+P", loaded.Shortcut("save"))
+	}
+	if err := loaded.LoadShortcuts(strings.NewReader(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Shortcut("save") != ctrlS || loaded.Rebound("print") {
+		t.Errorf("after loading no rebindings: Shortcut(save) = %v, want Ctrl+S", loaded.Shortcut("save"))
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"math/bits"
+)
+
+// DIBHeaderSize is the size of a BITMAPINFOHEADER.
+const DIBHeaderSize = 40
+
+// Compression of a BITMAPINFOHEADER.
+const (
+	biRGB       = 0
+	biBitfields = 3
+)
+
+// DecodeDIB decodes a device independent bitmap, as in CF_DIB clipboard data:
+// a BITMAPINFOHEADER or later header, then the color table and pixels.
+// Uncompressed 1, 4, 8, 16, 24 and 32 bit bitmaps are supported.
+func DecodeDIB(data []byte) (image.Image, error) {
+	le := binary.LittleEndian
+	if len(data) < DIBHeaderSize {
+		return nil, errors.New("DIB header is too short")
+	}
+	// Sizes are checked against the data as uint64, so they can not overflow int.
+	headerSize := le.Uint32(data[0:])
+	width := int64(int32(le.Uint32(data[4:])))
+	height := int64(int32(le.Uint32(data[8:])))
+	bitCount := int(le.Uint16(data[14:]))
+	compression := le.Uint32(data[16:])
+	colorsUsed := le.Uint32(data[32:])
+	if headerSize < DIBHeaderSize || uint64(headerSize) > uint64(len(data)) || width <= 0 || height == 0 {
+		return nil, errors.New("invalid DIB header")
+	}
+	// Top-down bitmaps have a negative height.
+	topDown := height < 0
+	if topDown {
+		height = -height
+	}
+
+	offset := int(headerSize)
+	var masks [4]uint32 // red, green, blue, alpha
+	switch {
+	case compression == biRGB && bitCount == 16:
+		masks = [4]uint32{0x7C00, 0x03E0, 0x001F, 0}
+	case compression == biRGB && bitCount == 32:
+		masks = [4]uint32{0xFF0000, 0xFF00, 0xFF, 0xFF000000}
+	case compression == biBitfields && (bitCount == 16 || bitCount == 32):
+		// The masks follow a BITMAPINFOHEADER, later headers hold them.
+		if headerSize == DIBHeaderSize {
+			offset += 12
+		}
+		if len(data) < DIBHeaderSize+12 {
+			return nil, errors.New("DIB color masks are missing")
+		}
+		for i := range 3 {
+			masks[i] = le.Uint32(data[DIBHeaderSize+4*i:])
+		}
+		if headerSize >= DIBHeaderSize+16 {
+			masks[3] = le.Uint32(data[DIBHeaderSize+12:])
+		}
+	case compression == biRGB && (bitCount == 1 || bitCount == 4 || bitCount == 8 || bitCount == 24):
+	default:
+		return nil, fmt.Errorf("unsupported DIB: %d bits, compression %d", bitCount, compression)
+	}
+
+	if bitCount <= 8 && (colorsUsed == 0 || colorsUsed > 1<<bitCount) {
+		colorsUsed = 1 << bitCount
+	}
+	// Bitmaps of more than 8 bits may have a color table too, for palette devices.
+	if uint64(colorsUsed) > uint64(len(data)-offset)/4 {
+		return nil, errors.New("DIB color table is too short")
+	}
+	var palette color.Palette
+	if bitCount <= 8 {
+		for i := range int(colorsUsed) {
+			quad := data[offset+4*i:]
+			palette = append(palette, color.NRGBA{quad[2], quad[1], quad[0], 0xFF})
+		}
+	}
+	offset += 4 * int(colorsUsed)
+
+	// Rows are padded to 4 bytes.
+	stride64 := (uint64(width)*uint64(bitCount) + 31) / 32 * 4
+	if uint64(height) > uint64(len(data)-offset)/stride64 {
+		return nil, errors.New("DIB pixels are too short")
+	}
+	stride := int(stride64)
+
+	img := image.NewNRGBA(image.Rect(0, 0, int(width), int(height)))
+	// 32 bit bitmaps often leave the alpha byte 0, they are then opaque.
+	noAlpha := true
+	for y := range int(height) {
+		row := data[offset+stride*y:]
+		if !topDown {
+			row = data[offset+stride*(int(height)-1-y):]
+		}
+		for x := range int(width) {
+			var c color.NRGBA
+			switch bitCount {
+			case 1, 4, 8:
+				bit := x * bitCount
+				index := int(row[bit/8]>>(8-bitCount-bit%8)) & (1<<bitCount - 1)
+				if index < len(palette) {
+					c = palette[index].(color.NRGBA)
+				}
+			case 24:
+				c = color.NRGBA{row[3*x+2], row[3*x+1], row[3*x], 0xFF}
+			case 16, 32:
+				var pixel uint32
+				if bitCount == 16 {
+					pixel = uint32(le.Uint16(row[2*x:]))
+				} else {
+					pixel = le.Uint32(row[4*x:])
+				}
+				c = color.NRGBA{dibChannel(pixel, masks[0]), dibChannel(pixel, masks[1]), dibChannel(pixel, masks[2]), 0xFF}
+				if masks[3] != 0 {
+					c.A = dibChannel(pixel, masks[3])
+					noAlpha = noAlpha && c.A == 0
+				}
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	if masks[3] != 0 && noAlpha {
+		for i := 3; i < len(img.Pix); i += 4 {
+			img.Pix[i] = 0xFF
+		}
+	}
+	return img, nil
+}
+
+// dibChannel extracts the bits of mask from pixel, scaled to 8 bits.
+func dibChannel(pixel, mask uint32) uint8 {
+	if mask == 0 {
+		return 0
+	}
+	value := (pixel & mask) >> bits.TrailingZeros32(mask)
+	return uint8(uint64(value) * 0xFF / uint64(mask>>bits.TrailingZeros32(mask)))
+}
+
+// EncodeDIB encodes img as a 32 bit bottom-up device independent bitmap, as in CF_DIB clipboard data.
+func EncodeDIB(img image.Image) []byte {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	data := make([]byte, DIBHeaderSize+4*width*height)
+
+	le := binary.LittleEndian
+	le.PutUint32(data[0:], DIBHeaderSize)
+	le.PutUint32(data[4:], uint32(width))
+	le.PutUint32(data[8:], uint32(height))
+	le.PutUint16(data[12:], 1) // planes
+	le.PutUint16(data[14:], 32)
+	le.PutUint32(data[16:], biRGB)
+	le.PutUint32(data[20:], uint32(4*width*height))
+
+	pixels := data[DIBHeaderSize:]
+	for y := range int(height) {
+		row := pixels[4*width*(height-1-y):]
+		for x := range int(width) {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			copy(row[4*x:], []byte{c.B, c.G, c.R, c.A})
+		}
+	}
+	return data
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"slices"
+	"testing"
+)
+
+// makeDIB builds a DIB with extra, the masks or color table, after the header,
+// and rows in the order they are stored, padded to 4 bytes.
+func makeDIB(headerSize, width, height, bitCount int, compression uint32, colorsUsed int, extra []byte, rows ...[]byte) []byte {
+	le := binary.LittleEndian
+	data := make([]byte, headerSize)
+	le.PutUint32(data[0:], uint32(headerSize))
+	le.PutUint32(data[4:], uint32(int32(width)))
+	le.PutUint32(data[8:], uint32(int32(height)))
+	le.PutUint16(data[12:], 1)
+	le.PutUint16(data[14:], uint16(bitCount))
+	le.PutUint32(data[16:], compression)
+	le.PutUint32(data[32:], uint32(colorsUsed))
+	if headerSize > DIBHeaderSize {
+		// Later headers hold the masks themselves.
+		copy(data[DIBHeaderSize:], extra)
+		extra = nil
+	}
+	data = append(data, extra...)
+	stride := (width*bitCount + 31) / 32 * 4
+	for _, row := range rows {
+		data = append(data, row...)
+		data = append(data, make([]byte, stride-len(row))...)
+	}
+	return data
+}
+
+func quads(colors ...color.NRGBA) []byte {
+	var data []byte
+	for _, c := range colors {
+		data = append(data, c.B, c.G, c.R, 0)
+	}
+	return data
+}
+
+func masks(masks ...uint32) []byte {
+	var data []byte
+	for _, mask := range masks {
+		data = binary.LittleEndian.AppendUint32(data, mask)
+	}
+	return data
+}
+
+var (
+	black = color.NRGBA{0, 0, 0, 0xFF}
+	white = color.NRGBA{0xFF, 0xFF, 0xFF, 0xFF}
+	red   = color.NRGBA{0xFF, 0, 0, 0xFF}
+	green = color.NRGBA{0, 0xFF, 0, 0xFF}
+	blue  = color.NRGBA{0, 0, 0xFF, 0xFF}
+)
+
+func TestDecodeDIB(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          []byte
+		width, height int
+		want          []color.NRGBA // top-down
+	}{
+		{"1 bit bottom-up", makeDIB(40, 2, 2, 1, biRGB, 0, quads(black, white), []byte{0x80}, []byte{0x40}),
+			2, 2, []color.NRGBA{black, white, white, black}},
+		{"4 bit, index past the color table", makeDIB(40, 3, 1, 4, biRGB, 3, quads(red, green, blue), []byte{0x12, 0x30}),
+			3, 1, []color.NRGBA{green, blue, {}}},
+		{"8 bit top-down", makeDIB(40, 1, -2, 8, biRGB, 2, quads(red, green), []byte{0}, []byte{1}),
+			1, 2, []color.NRGBA{red, green}},
+		{"24 bit", makeDIB(40, 1, 1, 24, biRGB, 0, nil, []byte{1, 2, 3}),
+			1, 1, []color.NRGBA{{3, 2, 1, 0xFF}}},
+		{"16 bit 555", makeDIB(40, 2, 1, 16, biRGB, 0, nil, []byte{0x00, 0x7C, 0x1F, 0x00}),
+			2, 1, []color.NRGBA{red, blue}},
+		{"16 bit 565 bitfields", makeDIB(40, 2, 1, 16, biBitfields, 0, masks(0xF800, 0x07E0, 0x001F), []byte{0xE0, 0x07, 0x00, 0xF8}),
+			2, 1, []color.NRGBA{green, red}},
+		{"32 bit without alpha is opaque", makeDIB(40, 1, 1, 32, biRGB, 0, nil, []byte{10, 20, 30, 0}),
+			1, 1, []color.NRGBA{{30, 20, 10, 0xFF}}},
+		{"32 bit with alpha", makeDIB(40, 2, 1, 32, biRGB, 0, nil, []byte{10, 20, 30, 0x80, 1, 2, 3, 0}),
+			2, 1, []color.NRGBA{{30, 20, 10, 0x80}, {3, 2, 1, 0}}},
+		{"24 bit with a color table", makeDIB(40, 1, 1, 24, biRGB, 2, quads(red, green), []byte{1, 2, 3}),
+			1, 1, []color.NRGBA{{3, 2, 1, 0xFF}}},
+		{"32 bit bitfields with a color table", makeDIB(40, 1, 1, 32, biBitfields, 1, append(masks(0xFF, 0xFF00, 0xFF0000), quads(red)...), []byte{1, 2, 3, 4}),
+			1, 1, []color.NRGBA{{1, 2, 3, 0xFF}}},
+		{"32 bit bitfields in a later header", makeDIB(56, 1, 1, 32, biBitfields, 0, masks(0xFF, 0xFF00, 0xFF0000, 0xFF000000), []byte{1, 2, 3, 4}),
+			1, 1, []color.NRGBA{{1, 2, 3, 4}}},
+	}
+	for _, test := range tests {
+		img, err := DecodeDIB(test.data)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if size := img.Bounds().Size(); size != image.Pt(test.width, test.height) {
+			t.Errorf("%s: size %v, want %dx%d", test.name, size, test.width, test.height)
+			continue
+		}
+		var got []color.NRGBA
+		for y := range test.height {
+			for x := range test.width {
+				got = append(got, img.(*image.NRGBA).NRGBAAt(x, y))
+			}
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%s: pixels %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDecodeDIBErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"short header", make([]byte, DIBHeaderSize-1)},
+		{"zero width", makeDIB(40, 0, 1, 24, biRGB, 0, nil)},
+		{"zero height", makeDIB(40, 1, 0, 24, biRGB, 0, nil)},
+		{"header size past the data", func() []byte {
+			data := makeDIB(40, 1, 1, 24, biRGB, 0, nil, []byte{0, 0, 0})
+			binary.LittleEndian.PutUint32(data, 100)
+			return data
+		}()},
+		{"compressed", makeDIB(40, 1, 1, 8, 1, 0, nil, []byte{0})},
+		{"24 bit bitfields", makeDIB(40, 1, 1, 24, biBitfields, 0, masks(1, 2, 3), []byte{0, 0, 0})},
+		{"missing masks", makeDIB(40, 1, 1, 32, biBitfields, 0, nil)},
+		{"short color table", makeDIB(40, 1, 1, 8, biRGB, 4, quads(red))},
+		{"short pixels", makeDIB(40, 2, 2, 24, biRGB, 0, nil, []byte{0, 0, 0, 0, 0, 0})},
+		{"huge size", makeDIB(40, 0x7FFFFFFF, 0x7FFFFFFF, 32, biRGB, 0, nil)},
+		{"huge top-down size", makeDIB(40, 0x7FFFFFFF, -0x80000000, 32, biRGB, 0, nil)},
+		{"huge color table", makeDIB(40, 1, 1, 24, biRGB, -1, nil, []byte{0, 0, 0})},
+	}
+	for _, test := range tests {
+		if _, err := DecodeDIB(test.data); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}
+
+func TestEncodeDIB(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(5, 7, 8, 9))
+	colors := []color.NRGBA{red, green, blue, {1, 2, 3, 4}, {0xFF, 0x80, 0, 0x80}, {}}
+	for i, c := range colors {
+		img.SetNRGBA(5+i%3, 7+i/3, c)
+	}
+
+	data := EncodeDIB(img)
+	le := binary.LittleEndian
+	if got := len(data); got != DIBHeaderSize+4*3*2 {
+		t.Fatalf("size %d, want %d", got, DIBHeaderSize+4*3*2)
+	}
+	if width, height := int32(le.Uint32(data[4:])), int32(le.Uint32(data[8:])); width != 3 || height != 2 {
+		t.Errorf("header size %dx%d, want 3x2 bottom-up", width, height)
+	}
+	if got := le.Uint16(data[14:]); got != 32 {
+		t.Errorf("header bit count %d, want 32", got)
+	}
+	// Bottom-up, so the first stored pixel is the bottom left one.
+	if got := data[DIBHeaderSize : DIBHeaderSize+4]; !slices.Equal(got, []byte{3, 2, 1, 4}) {
+		t.Errorf("first stored pixel %v, want BGRA of the bottom left", got)
+	}
+
+	decoded, err := DecodeDIB(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range colors {
+		if got := decoded.(*image.NRGBA).NRGBAAt(i%3, i/3); got != want {
+			t.Errorf("pixel %d,%d = %v after a round trip, want %v", i%3, i/3, got, want)
+		}
+	}
+}
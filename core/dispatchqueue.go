@@ -0,0 +1,86 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import "sync"
+
+// DispatchQueue collects functions posted from any goroutine
+// and runs them on the goroutine that calls Drain.
+//
+// Wake is called when the queue stops being empty, so the owner of the queue
+// knows to call Drain. It must not block; for the UI thread it posts a message
+// to the message loop.
+type DispatchQueue struct {
+	mu      sync.Mutex
+	pending []func()
+	wake    func()
+}
+
+func NewDispatchQueue(wake func()) *DispatchQueue {
+	return &DispatchQueue{wake: wake}
+}
+
+// BeginInvoke queues fn and returns without waiting for it to run.
+func (queue *DispatchQueue) BeginInvoke(fn func()) {
+	queue.mu.Lock()
+	wake := len(queue.pending) == 0
+	queue.pending = append(queue.pending, fn)
+	queue.mu.Unlock()
+
+	if wake && queue.wake != nil {
+		queue.wake()
+	}
+}
+
+// Invoke queues fn and waits until Drain ran it.
+// A panic in fn is raised again in the caller of Invoke.
+// Calling Invoke from the goroutine that drains the queue deadlocks.
+func (queue *DispatchQueue) Invoke(fn func()) {
+	done := make(chan any, 1)
+	queue.BeginInvoke(func() {
+		defer func() {
+			done <- recover()
+		}()
+		fn()
+	})
+	if r := <-done; r != nil {
+		panic(r)
+	}
+}
+
+// Pending returns the number of functions waiting to run.
+func (queue *DispatchQueue) Pending() int {
+	queue.mu.Lock()
+	defer queue.mu.Unlock()
+	return len(queue.pending)
+}
+
+// Drain runs the functions queued so far, in order, and returns how many ran.
+// Functions queued while draining wait for the next Drain.
+// A panic in a function queued by BeginInvoke does not stop the others from
+// running; the first one is raised again once all ran. Panics in functions
+// queued by Invoke are raised in the caller of Invoke instead.
+func (queue *DispatchQueue) Drain() int {
+	queue.mu.Lock()
+	pending := queue.pending
+	queue.pending = nil
+	queue.mu.Unlock()
+
+	var panicked any
+	for _, fn := range pending {
+		func() {
+			defer func() {
+				if r := recover(); r != nil && panicked == nil {
+					panicked = r
+				}
+			}()
+			fn()
+		}()
+	}
+	if panicked != nil {
+		panic(panicked)
+	}
+	return len(pending)
+}
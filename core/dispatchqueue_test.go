@@ -0,0 +1,168 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// fakePump stands in for the message loop: wake posts a message to it,
+// and it drains the queue for every message until stopped.
+type fakePump struct {
+	queue    *DispatchQueue
+	messages chan struct{}
+	wakes    int
+	mu       sync.Mutex
+}
+
+func newFakePump() *fakePump {
+	pump := &fakePump{messages: make(chan struct{}, 100)}
+	pump.queue = NewDispatchQueue(func() {
+		pump.mu.Lock()
+		pump.wakes++
+		pump.mu.Unlock()
+		pump.messages <- struct{}{}
+	})
+	return pump
+}
+
+func (pump *fakePump) wakeCount() int {
+	pump.mu.Lock()
+	defer pump.mu.Unlock()
+	return pump.wakes
+}
+
+// run drains the queue for each message until done is closed.
+func (pump *fakePump) run(done <-chan struct{}) {
+	for {
+		select {
+		case <-pump.messages:
+			pump.queue.Drain()
+		case <-done:
+			return
+		}
+	}
+}
+
+func TestDispatchQueueOrderAndWake(t *testing.T) {
+	pump := newFakePump()
+	var ran []int
+	for i := range 3 {
+		pump.queue.BeginInvoke(func() { ran = append(ran, i) })
+	}
+	if got := pump.wakeCount(); got != 1 {
+		t.Errorf("wake called %d times before Drain, want once", got)
+	}
+	if got := pump.queue.Pending(); got != 3 {
+		t.Errorf("Pending() = %d, want 3", got)
+	}
+	if n := pump.queue.Drain(); n != 3 {
+		t.Errorf("Drain() = %d, want 3", n)
+	}
+	if !slices.Equal(ran, []int{0, 1, 2}) {
+		t.Errorf("ran %v, want in order", ran)
+	}
+
+	pump.queue.BeginInvoke(func() {})
+	if got := pump.wakeCount(); got != 2 {
+		t.Errorf("wake called %d times after Drain emptied the queue, want 2", got)
+	}
+}
+
+func TestDispatchQueueQueuedWhileDraining(t *testing.T) {
+	queue := NewDispatchQueue(nil)
+	later := false
+	queue.BeginInvoke(func() {
+		queue.BeginInvoke(func() { later = true })
+	})
+	if n := queue.Drain(); n != 1 || later {
+		t.Fatalf("Drain() = %d, later ran %v: functions queued while draining must wait", n, later)
+	}
+	if n := queue.Drain(); n != 1 || !later {
+		t.Errorf("second Drain() = %d, later ran %v", n, later)
+	}
+}
+
+func TestDispatchQueueInvoke(t *testing.T) {
+	pump := newFakePump()
+	done := make(chan struct{})
+	defer close(done)
+	go pump.run(done)
+
+	var wg sync.WaitGroup
+	results := make([]int, 10)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pump.queue.Invoke(func() { results[i] = i * i })
+		}()
+	}
+	wg.Wait()
+	for i, got := range results {
+		if got != i*i {
+			t.Errorf("result %d = %d, want %d: Invoke returned before fn ran", i, got, i*i)
+		}
+	}
+}
+
+func TestDispatchQueueInvokePanic(t *testing.T) {
+	pump := newFakePump()
+	done := make(chan struct{})
+	defer close(done)
+	go pump.run(done)
+
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("recovered %v, want the panic of fn", r)
+		}
+	}()
+	pump.queue.Invoke(func() { panic("boom") })
+	t.Error("Invoke returned normally")
+}
+
+func TestDispatchQueueDrainPanicRunsRest(t *testing.T) {
+	pump := newFakePump()
+	var ran []string
+	pump.queue.BeginInvoke(func() { ran = append(ran, "first") })
+	pump.queue.BeginInvoke(func() { panic("boom") })
+	pump.queue.BeginInvoke(func() { panic("again") })
+	pump.queue.BeginInvoke(func() { ran = append(ran, "fourth") })
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("recovered %v, want the first panic", r)
+			}
+		}()
+		pump.queue.Drain()
+	}()
+	if !slices.Equal(ran, []string{"first", "fourth"}) {
+		t.Errorf("ran %v, want the rest of the batch to run", ran)
+	}
+	if got := pump.queue.Pending(); got != 0 {
+		t.Errorf("Pending() after a panic = %d, want 0", got)
+	}
+}
+
+func TestDispatchQueueInvokePanicLeavesDrain(t *testing.T) {
+	// The panic goes to the caller of Invoke, not to the goroutine draining.
+	queue := NewDispatchQueue(nil)
+	recovered := make(chan any)
+	go func() {
+		defer func() { recovered <- recover() }()
+		queue.Invoke(func() { panic("boom") })
+	}()
+	for queue.Pending() == 0 {
+		runtime.Gosched()
+	}
+	queue.Drain()
+	if r := <-recovered; r != "boom" {
+		t.Errorf("Invoke caller recovered %v, want boom", r)
+	}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+// Direction is the side of the remaining space a docked node takes.
+type Direction int
+
+const (
+	Top Direction = iota
+	TopLeft
+	TopCenter
+	TopRight
+	Bottom
+	BottomLeft
+	BottomCenter
+	BottomRight
+	Left
+	LeftTop
+	LeftCenter
+	LeftBottom
+	Right
+	RightTop
+	RightCenter
+	RightBottom
+	Fill
+	Center
+)
+
+// DockItem is a node and the side it is docked to.
+type DockItem struct {
+	Node LayoutNode
+	Dir  Direction
+}
+
+// DockContentSize is the smallest size that fits items at their size.
+// With sizeToContent it is the preferred size of nodes that hint one.
+func DockContentSize(items []DockItem, sizeToContent bool) (width, height int) {
+	// Walk back from the last docked child, which gets the space left by the others.
+	for i := len(items) - 1; i >= 0; i-- {
+		c := items[i]
+		if !c.Node.Visible() {
+			continue
+		}
+		child_width, child_height := nodeSize(c.Node, sizeToContent)
+		child_width += c.Node.MarginLeft() + c.Node.MarginRight()
+		child_height += c.Node.MarginTop() + c.Node.MarginBtm()
+
+		switch c.Dir {
+		case Top, TopLeft, TopCenter, TopRight, Bottom, BottomLeft, BottomCenter, BottomRight:
+			width = max(width, child_width)
+			height += child_height
+		case Left, LeftTop, LeftCenter, LeftBottom, Right, RightTop, RightCenter, RightBottom:
+			width += child_width
+			height = max(height, child_height)
+		default:
+			width = max(width, child_width)
+			height = max(height, child_height)
+		}
+	}
+	return width, height
+}
+
+// Dock lays out items in order within the area from x0, y0 to x1, y1.
+// Each item takes a side of the space left by the items before it.
+func Dock(items []DockItem, x0, y0, x1, y1 int, sizeToContent bool) {
+	control_width, control_height := x1-x0,
+		y1-y0
+
+	for _, c := range items {
+		child_width, child_height := nodeSize(c.Node, sizeToContent)
+		child_h_margin := c.Node.MarginLeft() + c.Node.MarginRight()
+		child_v_margin := c.Node.MarginTop() + c.Node.MarginBtm()
+
+		total_child_width := child_width + child_h_margin
+		total_child_height := child_height + child_v_margin
+		// Non visible controls do not reserve space.
+		if !c.Node.Visible() {
+			total_child_width = 0
+			total_child_height = 0
+		}
+
+		// Determine child start point
+		child_x := x0 + c.Node.MarginLeft()
+		child_y := y0 + c.Node.MarginTop()
+		switch c.Dir {
+		// Center
+		case TopCenter, BottomCenter, Center:
+			child_x = child_x + (control_width-total_child_width)/2
+		// Right
+		case Right, RightTop, RightCenter, RightBottom, TopRight, BottomRight:
+			child_x = x1 - total_child_width + c.Node.MarginLeft()
+		}
+		switch c.Dir {
+		// Center
+		case LeftCenter, RightCenter, Center:
+			child_y = child_y + (control_height-total_child_height)/2
+		// Bottom
+		case Bottom, BottomLeft, BottomCenter, BottomRight, LeftBottom, RightBottom:
+			child_y = y1 - total_child_height + c.Node.MarginTop()
+		}
+
+		// Move child
+		c.Node.SetPos(child_x, child_y)
+
+		// case Top, Bottom,Left,Right,Fill:
+		// Resize child to fill space
+		switch c.Dir {
+		case Top, Bottom:
+			c.Node.SetSize(control_width-child_h_margin, child_height)
+		case Left, Right:
+			c.Node.SetSize(child_width, control_height-child_v_margin)
+		case Fill:
+			c.Node.SetSize(control_width-child_h_margin, control_height-child_v_margin)
+		default:
+			if sizeToContent {
+				c.Node.SetSize(child_width, child_height)
+			}
+		}
+
+		// adjust available height and drawing corners
+		switch c.Dir {
+		case Top, TopLeft, TopCenter, TopRight:
+			y0 += total_child_height
+
+		case Bottom, BottomLeft, BottomCenter, BottomRight:
+			y1 -= total_child_height
+
+		case Left, LeftTop, LeftCenter, LeftBottom:
+			x0 += total_child_width
+
+		case Right, RightTop, RightCenter, RightBottom:
+			x1 -= total_child_width
+		}
+		switch c.Dir {
+		// Top, Bottom:
+		case Top, TopLeft, TopCenter, TopRight, Bottom, BottomLeft, BottomCenter, BottomRight:
+			control_height -= total_child_height
+
+		// Left,Right:
+		case Left, LeftTop, LeftCenter, LeftBottom, Right, RightTop, RightCenter, RightBottom:
+			control_width -= total_child_width
+		}
+	}
+}
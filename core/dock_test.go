@@ -0,0 +1,162 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import "testing"
+
+// fakeNode is a LayoutNode that records the geometry it is given.
+type fakeNode struct {
+	x, y, width, height int
+	hidden              bool
+
+	left, top, right, btm int // margins
+}
+
+func (node *fakeNode) Pos() (x, y int)           { return node.x, node.y }
+func (node *fakeNode) Width() int                { return node.width }
+func (node *fakeNode) Height() int               { return node.height }
+func (node *fakeNode) Visible() bool             { return !node.hidden }
+func (node *fakeNode) MarginTop() int            { return node.top }
+func (node *fakeNode) MarginBtm() int            { return node.btm }
+func (node *fakeNode) MarginLeft() int           { return node.left }
+func (node *fakeNode) MarginRight() int          { return node.right }
+func (node *fakeNode) SetPos(x, y int)           { node.x, node.y = x, y }
+func (node *fakeNode) SetSize(width, height int) { node.width, node.height = width, height }
+
+// rect is the geometry of a node, to compare in tests.
+type rect struct{ x, y, width, height int }
+
+func geometry(node LayoutNode) rect {
+	x, y := node.Pos()
+	return rect{x, y, node.Width(), node.Height()}
+}
+
+func TestDock(t *testing.T) {
+	// A 20x10 node docked in a 100x80 area, then a node filling what is left.
+	tests := []struct {
+		name string
+		dir  Direction
+		node rect
+		rest rect
+	}{
+		{"Top", Top, rect{0, 0, 100, 10}, rect{0, 10, 100, 70}},
+		{"TopLeft", TopLeft, rect{0, 0, 20, 10}, rect{0, 10, 100, 70}},
+		{"TopCenter", TopCenter, rect{40, 0, 20, 10}, rect{0, 10, 100, 70}},
+		{"TopRight", TopRight, rect{80, 0, 20, 10}, rect{0, 10, 100, 70}},
+		{"Bottom", Bottom, rect{0, 70, 100, 10}, rect{0, 0, 100, 70}},
+		{"BottomLeft", BottomLeft, rect{0, 70, 20, 10}, rect{0, 0, 100, 70}},
+		{"BottomCenter", BottomCenter, rect{40, 70, 20, 10}, rect{0, 0, 100, 70}},
+		{"BottomRight", BottomRight, rect{80, 70, 20, 10}, rect{0, 0, 100, 70}},
+		{"Left", Left, rect{0, 0, 20, 80}, rect{20, 0, 80, 80}},
+		{"LeftTop", LeftTop, rect{0, 0, 20, 10}, rect{20, 0, 80, 80}},
+		{"LeftCenter", LeftCenter, rect{0, 35, 20, 10}, rect{20, 0, 80, 80}},
+		{"LeftBottom", LeftBottom, rect{0, 70, 20, 10}, rect{20, 0, 80, 80}},
+		{"Right", Right, rect{80, 0, 20, 80}, rect{0, 0, 80, 80}},
+		{"RightTop", RightTop, rect{80, 0, 20, 10}, rect{0, 0, 80, 80}},
+		{"RightCenter", RightCenter, rect{80, 35, 20, 10}, rect{0, 0, 80, 80}},
+		{"RightBottom", RightBottom, rect{80, 70, 20, 10}, rect{0, 0, 80, 80}},
+		{"Fill", Fill, rect{0, 0, 100, 80}, rect{0, 0, 100, 80}},
+		{"Center", Center, rect{40, 35, 20, 10}, rect{0, 0, 100, 80}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node, rest := &fakeNode{width: 20, height: 10}, &fakeNode{}
+			Dock([]DockItem{{node, test.dir}, {rest, Fill}}, 0, 0, 100, 80, false)
+			if got := geometry(node); got != test.node {
+				t.Errorf("node = %v, want %v", got, test.node)
+			}
+			if got := geometry(rest); got != test.rest {
+				t.Errorf("rest = %v, want %v", got, test.rest)
+			}
+		})
+	}
+}
+
+func TestDockMarginsAndOffset(t *testing.T) {
+	top := &fakeNode{width: 20, height: 10, left: 1, top: 2, right: 3, btm: 4}
+	left := &fakeNode{width: 20, height: 10, left: 5}
+	fill := &fakeNode{}
+	Dock([]DockItem{{top, Top}, {left, Left}, {fill, Fill}}, 10, 10, 110, 90, false)
+
+	for _, test := range []struct {
+		name string
+		node *fakeNode
+		want rect
+	}{
+		{"top", top, rect{11, 12, 96, 10}},
+		{"left", left, rect{15, 26, 20, 64}},
+		{"fill", fill, rect{35, 26, 75, 64}},
+	} {
+		if got := geometry(test.node); got != test.want {
+			t.Errorf("%s = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDockHidden(t *testing.T) {
+	hidden := &fakeNode{width: 20, height: 10, hidden: true}
+	fill := &fakeNode{}
+	Dock([]DockItem{{hidden, Top}, {fill, Fill}}, 0, 0, 100, 80, false)
+	if got, want := geometry(fill), (rect{0, 0, 100, 80}); got != want {
+		t.Errorf("fill = %v, want %v: a hidden node must not reserve space", got, want)
+	}
+}
+
+// hintedNode prefers a size other than its current one.
+type hintedNode struct {
+	fakeNode
+	preferredWidth, preferredHeight int
+}
+
+func (node *hintedNode) PreferredSize() (int, int) { return node.preferredWidth, node.preferredHeight }
+func (node *hintedNode) MinimumSize() (int, int)   { return 0, 0 }
+
+func TestDockSizeToContent(t *testing.T) {
+	tests := []struct {
+		name          string
+		sizeToContent bool
+		want          rect
+	}{
+		{"current size", false, rect{0, 0, 20, 10}},
+		{"preferred size", true, rect{0, 0, 30, 15}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			node := &hintedNode{fakeNode{width: 20, height: 10}, 30, 15}
+			Dock([]DockItem{{node, TopLeft}}, 0, 0, 100, 80, test.sizeToContent)
+			if got := geometry(node); got != test.want {
+				t.Errorf("node = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestDockContentSize(t *testing.T) {
+	tests := []struct {
+		name          string
+		dirs          []Direction
+		width, height int
+	}{
+		{"empty", nil, 0, 0},
+		{"stacked", []Direction{Top, Bottom}, 20, 20},
+		{"side by side", []Direction{Left, Right}, 40, 10},
+		{"top then fill", []Direction{Top, Fill}, 20, 20},
+		{"left then top", []Direction{Left, Top}, 40, 10},
+		{"top then left", []Direction{Top, Left, Left}, 40, 20},
+		{"center", []Direction{Center}, 20, 10},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var items []DockItem
+			for _, dir := range test.dirs {
+				items = append(items, DockItem{&fakeNode{width: 20, height: 10}, dir})
+			}
+			width, height := DockContentSize(items, false)
+			if width != test.width || height != test.height {
+				t.Errorf("DockContentSize = %d, %d, want %d, %d", width, height, test.width, test.height)
+			}
+		})
+	}
+}
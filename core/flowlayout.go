@@ -0,0 +1,196 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+// FlowDirection is the direction a FlowLayout fills its lines.
+type FlowDirection int
+
+const (
+	FlowLeftToRight FlowDirection = iota
+	FlowTopToBottom
+)
+
+// FlowLayout places children one after another and wraps to a new line
+// when the client area is exceeded.
+type FlowLayout struct {
+	parent    LayoutContainer
+	children  []LayoutNode
+	direction FlowDirection
+
+	gap_horizontal, gap_vertical int
+
+	lineAlign, itemAlign Alignment
+
+	sizeToContent bool
+
+	layoutPadding
+}
+
+// NewFlowLayout creates a flow that lays out nodes within parent.
+func NewFlowLayout(parent LayoutContainer) *FlowLayout {
+	return &FlowLayout{parent: parent, lineAlign: AlignStart, itemAlign: AlignStart}
+}
+
+func (flow *FlowLayout) Add(child LayoutNode) {
+	flow.children = append(flow.children, child)
+}
+
+func (flow *FlowLayout) Remove(child LayoutNode) {
+	for i, c := range flow.children {
+		if c == child {
+			flow.children = append(flow.children[:i], flow.children[i+1:]...)
+			return
+		}
+	}
+}
+
+func (flow *FlowLayout) Direction() FlowDirection {
+	return flow.direction
+}
+
+func (flow *FlowLayout) SetDirection(direction FlowDirection) {
+	flow.direction = direction
+}
+
+// SetGaps sets space between neighbouring children and between lines.
+func (flow *FlowLayout) SetGaps(gap_horizontal, gap_vertical int) {
+	flow.gap_horizontal = gap_horizontal
+	flow.gap_vertical = gap_vertical
+}
+
+// SetLineAlignment aligns each line along the flow direction.
+// AlignStretch spreads the children over the whole line. Default is AlignStart.
+func (flow *FlowLayout) SetLineAlignment(alignment Alignment) {
+	flow.lineAlign = alignment
+}
+
+// SetItemAlignment aligns children across the flow direction within their line.
+// AlignStretch sizes children to the line. Default is AlignStart.
+func (flow *FlowLayout) SetItemAlignment(alignment Alignment) {
+	flow.itemAlign = alignment
+}
+
+// SetSizeToContent makes the flow use the preferred size of its children
+// instead of their current size.
+func (flow *FlowLayout) SetSizeToContent(enable bool) {
+	flow.sizeToContent = enable
+}
+
+// flowItem is a child measured along the flow (main) and across it (cross).
+type flowItem struct {
+	child LayoutNode
+
+	main, cross int
+	main_margin_start, main_margin_end,
+	cross_margin_start, cross_margin_end int
+}
+
+func (flow *FlowLayout) measure(child LayoutNode) *flowItem {
+	item := &flowItem{child: child}
+	width, height := nodeSize(child, flow.sizeToContent)
+	width, height = clampNode(child, width, height)
+	if flow.direction == FlowTopToBottom {
+		item.main, item.cross = height, width
+		item.main_margin_start, item.main_margin_end = child.MarginTop(), child.MarginBtm()
+		item.cross_margin_start, item.cross_margin_end = child.MarginLeft(), child.MarginRight()
+	} else {
+		item.main, item.cross = width, height
+		item.main_margin_start, item.main_margin_end = child.MarginLeft(), child.MarginRight()
+		item.cross_margin_start, item.cross_margin_end = child.MarginTop(), child.MarginBtm()
+	}
+	return item
+}
+
+func (item *flowItem) totalMain() int {
+	return item.main + item.main_margin_start + item.main_margin_end
+}
+
+func (item *flowItem) totalCross() int {
+	return item.cross + item.cross_margin_start + item.cross_margin_end
+}
+
+// Update is called to move child items into lines.
+func (flow *FlowLayout) Update() {
+	x0, y0 := flow.padding_left, flow.padding_top
+	width := flow.parent.ClientWidth() - flow.padding_left - flow.padding_right
+	height := flow.parent.ClientHeight() - flow.padding_top - flow.padding_btm
+
+	main_start, cross_start, main_space := x0, y0, width
+	main_gap, cross_gap := flow.gap_horizontal, flow.gap_vertical
+	if flow.direction == FlowTopToBottom {
+		main_start, cross_start, main_space = y0, x0, height
+		main_gap, cross_gap = flow.gap_vertical, flow.gap_horizontal
+	}
+
+	// Break children into lines.
+	var lines [][]*flowItem
+	var line []*flowItem
+	line_main := 0
+	for _, child := range flow.children {
+		// Non visible controls do not reserve space.
+		if !child.Visible() {
+			continue
+		}
+		item := flow.measure(child)
+		if len(line) > 0 && line_main+main_gap+item.totalMain() > main_space {
+			lines = append(lines, line)
+			line, line_main = nil, 0
+		}
+		if len(line) > 0 {
+			line_main += main_gap
+		}
+		line = append(line, item)
+		line_main += item.totalMain()
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	cross_pos := cross_start
+	for _, line := range lines {
+		line_main, line_cross := 0, 0
+		for _, item := range line {
+			line_main += item.totalMain()
+			line_cross = max(line_cross, item.totalCross())
+		}
+		line_main += main_gap * (len(line) - 1)
+
+		free := max(main_space-line_main, 0)
+		main_pos, extra_gap, extra_left := main_start, 0, 0
+		switch flow.lineAlign {
+		case AlignCenter:
+			main_pos += free / 2
+		case AlignEnd:
+			main_pos += free
+		case AlignStretch:
+			if len(line) > 1 {
+				extra_gap = free / (len(line) - 1)
+				extra_left = free % (len(line) - 1)
+			}
+		}
+
+		for _, item := range line {
+			cross_space := line_cross - item.cross_margin_start - item.cross_margin_end
+			offset, cross := flow.itemAlign.align(item.cross, cross_space)
+
+			main := main_pos + item.main_margin_start
+			across := cross_pos + item.cross_margin_start + offset
+			if flow.direction == FlowTopToBottom {
+				item.child.SetPos(across, main)
+				item.child.SetSize(clampNode(item.child, cross, item.main))
+			} else {
+				item.child.SetPos(main, across)
+				item.child.SetSize(clampNode(item.child, item.main, cross))
+			}
+
+			main_pos += item.totalMain() + main_gap + extra_gap
+			if extra_left > 0 {
+				main_pos++
+				extra_left--
+			}
+		}
+		cross_pos += line_cross + cross_gap
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import "testing"
+
+func TestFlowLayout(t *testing.T) {
+	// Three 20x10 children: with gaps of 5, two fit on a line of 50.
+	tests := []struct {
+		name       string
+		direction  FlowDirection
+		lineAlign  Alignment
+		gapH, gapV int
+		width      int
+		height     int
+		want       [3]rect
+	}{
+		{"start", FlowLeftToRight, AlignStart, 5, 5, 50, 100, [3]rect{{0, 0, 20, 10}, {25, 0, 20, 10}, {0, 15, 20, 10}}},
+		{"center", FlowLeftToRight, AlignCenter, 5, 5, 50, 100, [3]rect{{2, 0, 20, 10}, {27, 0, 20, 10}, {15, 15, 20, 10}}},
+		{"end", FlowLeftToRight, AlignEnd, 5, 5, 50, 100, [3]rect{{5, 0, 20, 10}, {30, 0, 20, 10}, {30, 15, 20, 10}}},
+		{"stretch", FlowLeftToRight, AlignStretch, 5, 5, 50, 100, [3]rect{{0, 0, 20, 10}, {30, 0, 20, 10}, {0, 15, 20, 10}}},
+		{"no gaps", FlowLeftToRight, AlignStart, 0, 0, 50, 100, [3]rect{{0, 0, 20, 10}, {20, 0, 20, 10}, {0, 10, 20, 10}}},
+		{"gaps push children to new lines", FlowLeftToRight, AlignStart, 15, 8, 50, 100, [3]rect{{0, 0, 20, 10}, {0, 18, 20, 10}, {0, 36, 20, 10}}},
+		{"horizontal and vertical gaps", FlowLeftToRight, AlignStart, 3, 10, 50, 100, [3]rect{{0, 0, 20, 10}, {23, 0, 20, 10}, {0, 20, 20, 10}}},
+		{"top to bottom", FlowTopToBottom, AlignStart, 5, 5, 100, 25, [3]rect{{0, 0, 20, 10}, {0, 15, 20, 10}, {25, 0, 20, 10}}},
+		{"top to bottom center", FlowTopToBottom, AlignCenter, 5, 5, 100, 25, [3]rect{{0, 0, 20, 10}, {0, 15, 20, 10}, {25, 7, 20, 10}}},
+		{"top to bottom end", FlowTopToBottom, AlignEnd, 5, 5, 100, 25, [3]rect{{0, 0, 20, 10}, {0, 15, 20, 10}, {25, 15, 20, 10}}},
+		{"top to bottom stretch", FlowTopToBottom, AlignStretch, 5, 5, 100, 30, [3]rect{{0, 0, 20, 10}, {0, 20, 20, 10}, {25, 0, 20, 10}}},
+		{"top to bottom gaps", FlowTopToBottom, AlignStart, 10, 3, 100, 25, [3]rect{{0, 0, 20, 10}, {0, 13, 20, 10}, {30, 0, 20, 10}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flow := NewFlowLayout(NewLayoutArea(test.width, test.height))
+			flow.SetDirection(test.direction)
+			flow.SetGaps(test.gapH, test.gapV)
+			flow.SetLineAlignment(test.lineAlign)
+			var nodes [3]*fakeNode
+			for i := range nodes {
+				nodes[i] = &fakeNode{width: 20, height: 10}
+				flow.Add(nodes[i])
+			}
+			flow.Update()
+			for i, node := range nodes {
+				if got := geometry(node); got != test.want[i] {
+					t.Errorf("child %d = %v, want %v", i, got, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFlowLayoutItemAlignment(t *testing.T) {
+	// Children of height 10, 30 and 20 on one line 30 high.
+	tests := []struct {
+		name      string
+		itemAlign Alignment
+		want      [3]rect
+	}{
+		{"start", AlignStart, [3]rect{{0, 0, 20, 10}, {20, 0, 20, 30}, {40, 0, 20, 20}}},
+		{"center", AlignCenter, [3]rect{{0, 10, 20, 10}, {20, 0, 20, 30}, {40, 5, 20, 20}}},
+		{"end", AlignEnd, [3]rect{{0, 20, 20, 10}, {20, 0, 20, 30}, {40, 10, 20, 20}}},
+		{"stretch", AlignStretch, [3]rect{{0, 0, 20, 30}, {20, 0, 20, 30}, {40, 0, 20, 30}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flow := NewFlowLayout(NewLayoutArea(100, 100))
+			flow.SetItemAlignment(test.itemAlign)
+			nodes := [3]*fakeNode{{width: 20, height: 10}, {width: 20, height: 30}, {width: 20, height: 20}}
+			for _, node := range nodes {
+				flow.Add(node)
+			}
+			flow.Update()
+			for i, node := range nodes {
+				if got := geometry(node); got != test.want[i] {
+					t.Errorf("child %d = %v, want %v", i, got, test.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+// GridUnit selects how a grid row or column is sized.
+type GridUnit int
+
+const (
+	GridUnitAuto  GridUnit = iota // fit the largest child
+	GridUnitFixed                 // fixed number of pixels
+	GridUnitStar                  // proportional share of the remaining space
+)
+
+// GridLength is the size definition of a grid row or column.
+type GridLength struct {
+	Unit  GridUnit
+	Value int // pixels for GridUnitFixed, weight for GridUnitStar
+}
+
+var GridAuto = GridLength{Unit: GridUnitAuto}
+
+func GridFixed(size int) GridLength {
+	return GridLength{GridUnitFixed, size}
+}
+
+func GridStar(weight int) GridLength {
+	return GridLength{GridUnitStar, weight}
+}
+
+// GridCell places a child in the grid.
+type GridCell struct {
+	child LayoutNode
+
+	row, col,
+	rowSpan, colSpan int
+
+	hAlign, vAlign Alignment
+}
+
+func (cell *GridCell) Child() LayoutNode {
+	return cell.child
+}
+
+func (cell *GridCell) SetSpan(rowSpan, colSpan int) {
+	cell.rowSpan = max(rowSpan, 1)
+	cell.colSpan = max(colSpan, 1)
+}
+
+// SetAlignment of the child within its cell. Default is AlignStretch.
+func (cell *GridCell) SetAlignment(horizontal, vertical Alignment) {
+	cell.hAlign = horizontal
+	cell.vAlign = vertical
+}
+
+/* GridLayout
+ *
+ */
+type GridLayout struct {
+	parent LayoutContainer
+	rows   []GridLength
+	cols   []GridLength
+	cells  []*GridCell
+
+	sizeToContent bool
+
+	layoutPadding
+}
+
+// NewGridLayout creates a grid that lays out nodes within parent.
+func NewGridLayout(parent LayoutContainer) *GridLayout {
+	return &GridLayout{parent: parent}
+}
+
+// SetRows replaces the row definitions. Rows used by cells but not defined are GridAuto.
+func (grid *GridLayout) SetRows(rows ...GridLength) {
+	grid.rows = rows
+}
+
+// SetColumns replaces the column definitions. Columns used by cells but not defined are GridAuto.
+func (grid *GridLayout) SetColumns(cols ...GridLength) {
+	grid.cols = cols
+}
+
+func (grid *GridLayout) AddRow(row GridLength) {
+	grid.rows = append(grid.rows, row)
+}
+
+func (grid *GridLayout) AddColumn(col GridLength) {
+	grid.cols = append(grid.cols, col)
+}
+
+// SetSizeToContent makes auto tracks and aligned children use the preferred size
+// of their children instead of their current size.
+func (grid *GridLayout) SetSizeToContent(enable bool) {
+	grid.sizeToContent = enable
+}
+
+// Add places child in a single cell.
+func (grid *GridLayout) Add(child LayoutNode, row, col int) *GridCell {
+	return grid.AddSpan(child, row, col, 1, 1)
+}
+
+// AddSpan places child in a cell spanning several rows and columns.
+func (grid *GridLayout) AddSpan(child LayoutNode, row, col, rowSpan, colSpan int) *GridCell {
+	cell := &GridCell{child: child, row: row, col: col}
+	cell.SetSpan(rowSpan, colSpan)
+	grid.cells = append(grid.cells, cell)
+	return cell
+}
+
+// Remove child from the grid.
+func (grid *GridLayout) Remove(child LayoutNode) {
+	for i, cell := range grid.cells {
+		if cell.child == child {
+			grid.cells = append(grid.cells[:i], grid.cells[i+1:]...)
+			return
+		}
+	}
+}
+
+// gridSpan is the space a child needs along one axis, margins included.
+type gridSpan struct {
+	start, span, size int
+}
+
+// gridTracks computes the size of each track along one axis.
+func gridTracks(lengths []GridLength, spans []gridSpan, available int) []int {
+	sizes := make([]int, len(lengths))
+	weights := 0
+	for i, length := range lengths {
+		switch length.Unit {
+		case GridUnitFixed:
+			sizes[i] = length.Value
+		case GridUnitStar:
+			weights += max(length.Value, 0)
+		}
+	}
+
+	// Auto tracks fit their single track children first,
+	for _, s := range spans {
+		if s.span == 1 && lengths[s.start].Unit == GridUnitAuto {
+			sizes[s.start] = max(sizes[s.start], s.size)
+		}
+	}
+	// then grow evenly for children spanning several tracks.
+	// Spans that include a star track get their space from it instead.
+	for _, s := range spans {
+		if s.span == 1 {
+			continue
+		}
+		covered, autos, stars := 0, 0, 0
+		for i := s.start; i < s.start+s.span; i++ {
+			covered += sizes[i]
+			switch lengths[i].Unit {
+			case GridUnitAuto:
+				autos++
+			case GridUnitStar:
+				stars++
+			}
+		}
+		if autos == 0 || stars > 0 || covered >= s.size {
+			continue
+		}
+		extra := s.size - covered
+		for i := s.start; i < s.start+s.span; i++ {
+			if lengths[i].Unit == GridUnitAuto {
+				share := extra / autos
+				sizes[i] += share
+				extra -= share
+				autos--
+			}
+		}
+	}
+
+	// Star tracks share whatever is left.
+	remaining := available
+	for i, length := range lengths {
+		if length.Unit != GridUnitStar {
+			remaining -= sizes[i]
+		}
+	}
+	remaining = max(remaining, 0)
+	for i, length := range lengths {
+		if length.Unit == GridUnitStar && weights > 0 {
+			weight := max(length.Value, 0)
+			share := remaining * weight / weights
+			sizes[i] = share
+			remaining -= share
+			weights -= weight
+		}
+	}
+	return sizes
+}
+
+// gridLengths returns definitions extended with GridAuto up to count.
+func gridLengths(defined []GridLength, count int) []GridLength {
+	lengths := make([]GridLength, max(len(defined), count))
+	copy(lengths, defined)
+	return lengths
+}
+
+// Update is called to resize child items based on grid definitions.
+func (grid *GridLayout) Update() {
+	x0, y0 := grid.padding_left, grid.padding_top
+	width := grid.parent.ClientWidth() - grid.padding_left - grid.padding_right
+	height := grid.parent.ClientHeight() - grid.padding_top - grid.padding_btm
+
+	var rowSpans, colSpans []gridSpan
+	var cells []*GridCell
+	var sizes [][2]int
+	rowCount, colCount := 0, 0
+	for _, cell := range grid.cells {
+		// Non visible controls do not reserve space.
+		if cell.row < 0 || cell.col < 0 || !cell.child.Visible() {
+			continue
+		}
+		cells = append(cells, cell)
+		rowCount = max(rowCount, cell.row+cell.rowSpan)
+		colCount = max(colCount, cell.col+cell.colSpan)
+
+		child_width, child_height := nodeSize(cell.child, grid.sizeToContent)
+		child_width, child_height = clampNode(cell.child, child_width, child_height)
+		sizes = append(sizes, [2]int{child_width, child_height})
+		child_h_margin := cell.child.MarginLeft() + cell.child.MarginRight()
+		child_v_margin := cell.child.MarginTop() + cell.child.MarginBtm()
+		rowSpans = append(rowSpans, gridSpan{cell.row, cell.rowSpan, child_height + child_v_margin})
+		colSpans = append(colSpans, gridSpan{cell.col, cell.colSpan, child_width + child_h_margin})
+	}
+
+	rows := gridTracks(gridLengths(grid.rows, rowCount), rowSpans, height)
+	cols := gridTracks(gridLengths(grid.cols, colCount), colSpans, width)
+
+	rowOffsets := make([]int, len(rows)+1)
+	rowOffsets[0] = y0
+	for i, size := range rows {
+		rowOffsets[i+1] = rowOffsets[i] + size
+	}
+	colOffsets := make([]int, len(cols)+1)
+	colOffsets[0] = x0
+	for i, size := range cols {
+		colOffsets[i+1] = colOffsets[i] + size
+	}
+
+	for i, cell := range cells {
+		child := cell.child
+		cell_x := colOffsets[cell.col] + child.MarginLeft()
+		cell_y := rowOffsets[cell.row] + child.MarginTop()
+		cell_width := colOffsets[cell.col+cell.colSpan] - cell_x - child.MarginRight()
+		cell_height := rowOffsets[cell.row+cell.rowSpan] - cell_y - child.MarginBtm()
+
+		dx, child_width := cell.hAlign.align(min(sizes[i][0], cell_width), cell_width)
+		dy, child_height := cell.vAlign.align(min(sizes[i][1], cell_height), cell_height)
+
+		// Constraints may keep a stretched child from filling its cell.
+		clamped_width, clamped_height := clampNode(child, child_width, child_height)
+		if cell.hAlign != AlignStretch {
+			dx, _ = cell.hAlign.align(clamped_width, cell_width)
+		}
+		if cell.vAlign != AlignStretch {
+			dy, _ = cell.vAlign.align(clamped_height, cell_height)
+		}
+
+		child.SetPos(cell_x+dx, cell_y+dy)
+		child.SetSize(clamped_width, clamped_height)
+	}
+}
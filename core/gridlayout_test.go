@@ -0,0 +1,163 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestGridTracks(t *testing.T) {
+	tests := []struct {
+		name      string
+		lengths   []GridLength
+		spans     []gridSpan
+		available int
+		want      []int
+	}{
+		{"fixed and stars", []GridLength{GridFixed(30), GridStar(1), GridStar(3)}, nil, 110, []int{30, 20, 60}},
+		{"auto fits largest", []GridLength{GridAuto, GridAuto}, []gridSpan{{0, 1, 15}, {0, 1, 25}, {1, 1, 5}}, 100, []int{25, 5}},
+		{"span grows autos evenly", []GridLength{GridAuto, GridAuto}, []gridSpan{{0, 1, 10}, {0, 2, 30}}, 100, []int{20, 10}},
+		{"span over a star", []GridLength{GridAuto, GridStar(1)}, []gridSpan{{0, 2, 50}}, 40, []int{0, 40}},
+		{"no room for stars", []GridLength{GridFixed(50), GridStar(1)}, nil, 30, []int{50, 0}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := gridTracks(test.lengths, test.spans, test.available); !slices.Equal(got, test.want) {
+				t.Errorf("gridTracks = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestGridLayoutUpdate(t *testing.T) {
+	// Each setup fills a grid in a 100x50 area and returns the nodes to check.
+	tests := []struct {
+		name  string
+		setup func(grid *GridLayout) []LayoutNode
+		want  []rect
+	}{
+		{"stretch and center", func(grid *GridLayout) []LayoutNode {
+			grid.SetColumns(GridStar(1), GridStar(1))
+			grid.SetRows(GridFixed(20))
+			stretched, centered := NewLayoutBox(10, 10), NewLayoutBox(10, 10)
+			grid.Add(stretched, 0, 0)
+			grid.Add(centered, 0, 1).SetAlignment(AlignCenter, AlignStretch)
+			return []LayoutNode{stretched, centered}
+		}, []rect{{0, 0, 50, 20}, {70, 0, 10, 20}}},
+		{"row and column span", func(grid *GridLayout) []LayoutNode {
+			grid.SetColumns(GridFixed(30), GridFixed(30), GridStar(1))
+			grid.SetRows(GridFixed(20), GridFixed(20))
+			spanned, single := NewLayoutBox(10, 10), NewLayoutBox(10, 10)
+			grid.AddSpan(spanned, 0, 0, 2, 2)
+			grid.Add(single, 0, 2)
+			return []LayoutNode{spanned, single}
+		}, []rect{{0, 0, 60, 40}, {60, 0, 40, 20}}},
+		{"span grows auto tracks", func(grid *GridLayout) []LayoutNode {
+			single, spanned := NewLayoutBox(10, 10), NewLayoutBox(30, 10)
+			grid.Add(single, 0, 0)
+			grid.AddSpan(spanned, 1, 0, 1, 2)
+			return []LayoutNode{single, spanned}
+		}, []rect{{0, 0, 20, 10}, {0, 10, 30, 10}}},
+		{"start, end and center alignment", func(grid *GridLayout) []LayoutNode {
+			grid.SetColumns(GridStar(1), GridStar(1))
+			grid.SetRows(GridStar(1))
+			bottomLeft, middleRight := NewLayoutBox(10, 10), NewLayoutBox(10, 10)
+			grid.Add(bottomLeft, 0, 0).SetAlignment(AlignStart, AlignEnd)
+			grid.Add(middleRight, 0, 1).SetAlignment(AlignEnd, AlignCenter)
+			return []LayoutNode{bottomLeft, middleRight}
+		}, []rect{{0, 40, 10, 10}, {90, 20, 10, 10}}},
+		{"margins", func(grid *GridLayout) []LayoutNode {
+			grid.SetColumns(GridFixed(50), GridStar(1))
+			grid.SetRows(GridFixed(30))
+			stretched, centered := NewLayoutBox(10, 10), NewLayoutBox(10, 10)
+			stretched.SetMargins(2, 3, 4, 5)
+			centered.SetMarginsAll(5)
+			grid.Add(stretched, 0, 0)
+			grid.Add(centered, 0, 1).SetAlignment(AlignCenter, AlignCenter)
+			return []LayoutNode{stretched, centered}
+		}, []rect{{2, 3, 44, 22}, {70, 10, 10, 10}}},
+		{"auto track includes margins", func(grid *GridLayout) []LayoutNode {
+			box := NewLayoutBox(10, 10)
+			box.SetMargins(3, 0, 7, 0)
+			grid.Add(box, 0, 0)
+			next := NewLayoutBox(10, 10)
+			grid.Add(next, 0, 1)
+			return []LayoutNode{box, next}
+		}, []rect{{3, 0, 10, 10}, {20, 0, 10, 10}}},
+		{"padding", func(grid *GridLayout) []LayoutNode {
+			grid.SetColumns(GridStar(1))
+			grid.SetRows(GridStar(1))
+			grid.SetPaddings(5, 10, 15, 20)
+			box := NewLayoutBox(10, 10)
+			grid.Add(box, 0, 0)
+			return []LayoutNode{box}
+		}, []rect{{5, 10, 80, 20}}},
+		{"min and max size", func(grid *GridLayout) []LayoutNode {
+			grid.SetColumns(GridStar(1), GridStar(1), GridAuto)
+			grid.SetRows(GridFixed(40))
+			stretched, aligned, grown := NewLayoutBox(10, 10), NewLayoutBox(10, 10), NewLayoutBox(10, 10)
+			stretched.SetMaxSize(30, 20)
+			aligned.SetMaxSize(0, 30)
+			grown.SetMinSize(20, 0)
+			grid.Add(stretched, 0, 0)
+			grid.Add(aligned, 0, 1).SetAlignment(AlignEnd, AlignStretch)
+			grid.Add(grown, 0, 2)
+			return []LayoutNode{stretched, aligned, grown}
+		}, []rect{{0, 0, 30, 20}, {70, 0, 10, 30}, {80, 0, 20, 40}}},
+		{"hidden", func(grid *GridLayout) []LayoutNode {
+			hidden, shown := NewLayoutBox(30, 10), NewLayoutBox(10, 10)
+			hidden.Hide()
+			grid.Add(hidden, 0, 0)
+			grid.Add(shown, 0, 1)
+			return []LayoutNode{hidden, shown}
+		}, []rect{{0, 0, 30, 10}, {0, 0, 10, 10}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			grid := NewGridLayout(NewLayoutArea(100, 50))
+			nodes := test.setup(grid)
+			grid.Update()
+			for i, node := range nodes {
+				if got := geometry(node); got != test.want[i] {
+					t.Errorf("node %d = %v, want %v", i, got, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestLayoutBoxClampSize(t *testing.T) {
+	tests := []struct {
+		name                  string
+		minWidth, minHeight   int
+		maxWidth, maxHeight   int
+		width, height         int
+		wantWidth, wantHeight int
+	}{
+		{"unconstrained", 0, 0, 0, 0, 50, 60, 50, 60},
+		{"min", 20, 30, 0, 0, 10, 10, 20, 30},
+		{"max, zero is unconstrained", 0, 0, 40, 0, 50, 60, 40, 60},
+		{"min and max", 10, 10, 20, 20, 5, 25, 10, 20},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			box := NewLayoutBox(0, 0)
+			box.SetMinSize(test.minWidth, test.minHeight)
+			box.SetMaxSize(test.maxWidth, test.maxHeight)
+			box.SetSize(test.width, test.height)
+			if width, height := box.Size(); width != test.wantWidth || height != test.wantHeight {
+				t.Errorf("Size = %d, %d, want %d, %d", width, height, test.wantWidth, test.wantHeight)
+			}
+		})
+	}
+
+	// Setting a constraint resizes the box to fit it.
+	box := NewLayoutBox(10, 10)
+	box.SetMinSize(15, 0)
+	if width, height := box.Size(); width != 15 || height != 10 {
+		t.Errorf("Size after SetMinSize = %d, %d, want 15, 10", width, height)
+	}
+}
@@ -0,0 +1,459 @@
+/*
+ * Copyright (C) 2019 The windigo Authors. All Rights Reserved.
+ * Copyright (C) 2010-2013 Allen Dang. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"bytes"
+	"strings"
+)
+
+type Key uint16
+
+func (k Key) String() string {
+	return key2string[k]
+}
+
+// LocalKeyName returns the name of a key in the language of the keyboard layout,
+// or "" if there is none. windigo sets it to ask Windows.
+var LocalKeyName func(k Key) string
+
+// DisplayName returns the name of the key in the language of the keyboard layout,
+// or String if there is none.
+func (k Key) DisplayName() string {
+	if LocalKeyName != nil {
+		if name := LocalKeyName(k); name != "" {
+			return name
+		}
+	}
+	return k.String()
+}
+
+const (
+	KeyLButton           Key = 0x01
+	KeyRButton           Key = 0x02
+	KeyCancel            Key = 0x03
+	KeyMButton           Key = 0x04
+	KeyXButton1          Key = 0x05
+	KeyXButton2          Key = 0x06
+	KeyBack              Key = 0x08
+	KeyTab               Key = 0x09
+	KeyClear             Key = 0x0C
+	KeyReturn            Key = 0x0D
+	KeyShift             Key = 0x10
+	KeyControl           Key = 0x11
+	KeyAlt               Key = 0x12
+	KeyMenu              Key = 0x12
+	KeyPause             Key = 0x13
+	KeyCapital           Key = 0x14
+	KeyKana              Key = 0x15
+	KeyHangul            Key = 0x15
+	KeyJunja             Key = 0x17
+	KeyFinal             Key = 0x18
+	KeyHanja             Key = 0x19
+	KeyKanji             Key = 0x19
+	KeyEscape            Key = 0x1B
+	KeyConvert           Key = 0x1C
+	KeyNonconvert        Key = 0x1D
+	KeyAccept            Key = 0x1E
+	KeyModeChange        Key = 0x1F
+	KeySpace             Key = 0x20
+	KeyPrior             Key = 0x21
+	KeyNext              Key = 0x22
+	KeyEnd               Key = 0x23
+	KeyHome              Key = 0x24
+	KeyLeft              Key = 0x25
+	KeyUp                Key = 0x26
+	KeyRight             Key = 0x27
+	KeyDown              Key = 0x28
+	KeySelect            Key = 0x29
+	KeyPrint             Key = 0x2A
+	KeyExecute           Key = 0x2B
+	KeySnapshot          Key = 0x2C
+	KeyInsert            Key = 0x2D
+	KeyDelete            Key = 0x2E
+	KeyHelp              Key = 0x2F
+	Key0                 Key = 0x30
+	Key1                 Key = 0x31
+	Key2                 Key = 0x32
+	Key3                 Key = 0x33
+	Key4                 Key = 0x34
+	Key5                 Key = 0x35
+	Key6                 Key = 0x36
+	Key7                 Key = 0x37
+	Key8                 Key = 0x38
+	Key9                 Key = 0x39
+	KeyA                 Key = 0x41
+	KeyB                 Key = 0x42
+	KeyC                 Key = 0x43
+	KeyD                 Key = 0x44
+	KeyE                 Key = 0x45
+	KeyF                 Key = 0x46
+	KeyG                 Key = 0x47
+	KeyH                 Key = 0x48
+	KeyI                 Key = 0x49
+	KeyJ                 Key = 0x4A
+	KeyK                 Key = 0x4B
+	KeyL                 Key = 0x4C
+	KeyM                 Key = 0x4D
+	KeyN                 Key = 0x4E
+	KeyO                 Key = 0x4F
+	KeyP                 Key = 0x50
+	KeyQ                 Key = 0x51
+	KeyR                 Key = 0x52
+	KeyS                 Key = 0x53
+	KeyT                 Key = 0x54
+	KeyU                 Key = 0x55
+	KeyV                 Key = 0x56
+	KeyW                 Key = 0x57
+	KeyX                 Key = 0x58
+	KeyY                 Key = 0x59
+	KeyZ                 Key = 0x5A
+	KeyLWIN              Key = 0x5B
+	KeyRWIN              Key = 0x5C
+	KeyApps              Key = 0x5D
+	KeySleep             Key = 0x5F
+	KeyNumpad0           Key = 0x60
+	KeyNumpad1           Key = 0x61
+	KeyNumpad2           Key = 0x62
+	KeyNumpad3           Key = 0x63
+	KeyNumpad4           Key = 0x64
+	KeyNumpad5           Key = 0x65
+	KeyNumpad6           Key = 0x66
+	KeyNumpad7           Key = 0x67
+	KeyNumpad8           Key = 0x68
+	KeyNumpad9           Key = 0x69
+	KeyMultiply          Key = 0x6A
+	KeyAdd               Key = 0x6B
+	KeySeparator         Key = 0x6C
+	KeySubtract          Key = 0x6D
+	KeyDecimal           Key = 0x6E
+	KeyDivide            Key = 0x6F
+	KeyF1                Key = 0x70
+	KeyF2                Key = 0x71
+	KeyF3                Key = 0x72
+	KeyF4                Key = 0x73
+	KeyF5                Key = 0x74
+	KeyF6                Key = 0x75
+	KeyF7                Key = 0x76
+	KeyF8                Key = 0x77
+	KeyF9                Key = 0x78
+	KeyF10               Key = 0x79
+	KeyF11               Key = 0x7A
+	KeyF12               Key = 0x7B
+	KeyF13               Key = 0x7C
+	KeyF14               Key = 0x7D
+	KeyF15               Key = 0x7E
+	KeyF16               Key = 0x7F
+	KeyF17               Key = 0x80
+	KeyF18               Key = 0x81
+	KeyF19               Key = 0x82
+	KeyF20               Key = 0x83
+	KeyF21               Key = 0x84
+	KeyF22               Key = 0x85
+	KeyF23               Key = 0x86
+	KeyF24               Key = 0x87
+	KeyNumlock           Key = 0x90
+	KeyScroll            Key = 0x91
+	KeyLShift            Key = 0xA0
+	KeyRShift            Key = 0xA1
+	KeyLControl          Key = 0xA2
+	KeyRControl          Key = 0xA3
+	KeyLAlt              Key = 0xA4
+	KeyLMenu             Key = 0xA4
+	KeyRAlt              Key = 0xA5
+	KeyRMenu             Key = 0xA5
+	KeyBrowserBack       Key = 0xA6
+	KeyBrowserForward    Key = 0xA7
+	KeyBrowserRefresh    Key = 0xA8
+	KeyBrowserStop       Key = 0xA9
+	KeyBrowserSearch     Key = 0xAA
+	KeyBrowserFavorites  Key = 0xAB
+	KeyBrowserHome       Key = 0xAC
+	KeyVolumeMute        Key = 0xAD
+	KeyVolumeDown        Key = 0xAE
+	KeyVolumeUp          Key = 0xAF
+	KeyMediaNextTrack    Key = 0xB0
+	KeyMediaPrevTrack    Key = 0xB1
+	KeyMediaStop         Key = 0xB2
+	KeyMediaPlayPause    Key = 0xB3
+	KeyLaunchMail        Key = 0xB4
+	KeyLaunchMediaSelect Key = 0xB5
+	KeyLaunchApp1        Key = 0xB6
+	KeyLaunchApp2        Key = 0xB7
+	KeyOEM1              Key = 0xBA
+	KeyOEMPlus           Key = 0xBB
+	KeyOEMComma          Key = 0xBC
+	KeyOEMMinus          Key = 0xBD
+	KeyOEMPeriod         Key = 0xBE
+	KeyOEM2              Key = 0xBF
+	KeyOEM3              Key = 0xC0
+	KeyOEM4              Key = 0xDB
+	KeyOEM5              Key = 0xDC
+	KeyOEM6              Key = 0xDD
+	KeyOEM7              Key = 0xDE
+	KeyOEM8              Key = 0xDF
+	KeyOEM102            Key = 0xE2
+	KeyProcessKey        Key = 0xE5
+	KeyPacket            Key = 0xE7
+	KeyAttn              Key = 0xF6
+	KeyCRSel             Key = 0xF7
+	KeyEXSel             Key = 0xF8
+	KeyErEOF             Key = 0xF9
+	KeyPlay              Key = 0xFA
+	KeyZoom              Key = 0xFB
+	KeyNoName            Key = 0xFC
+	KeyPA1               Key = 0xFD
+	KeyOEMClear          Key = 0xFE
+)
+
+var key2string = map[Key]string{
+	KeyLButton:           "LButton",
+	KeyRButton:           "RButton",
+	KeyCancel:            "Cancel",
+	KeyMButton:           "MButton",
+	KeyXButton1:          "XButton1",
+	KeyXButton2:          "XButton2",
+	KeyBack:              "Back",
+	KeyTab:               "Tab",
+	KeyClear:             "Clear",
+	KeyReturn:            "Return",
+	KeyShift:             "Shift",
+	KeyControl:           "Control",
+	KeyAlt:               "Alt / Menu",
+	KeyPause:             "Pause",
+	KeyCapital:           "Capital",
+	KeyKana:              "Kana / Hangul",
+	KeyJunja:             "Junja",
+	KeyFinal:             "Final",
+	KeyHanja:             "Hanja / Kanji",
+	KeyEscape:            "Escape",
+	KeyConvert:           "Convert",
+	KeyNonconvert:        "Nonconvert",
+	KeyAccept:            "Accept",
+	KeyModeChange:        "ModeChange",
+	KeySpace:             "Space",
+	KeyPrior:             "Prior",
+	KeyNext:              "Next",
+	KeyEnd:               "End",
+	KeyHome:              "Home",
+	KeyLeft:              "Left",
+	KeyUp:                "Up",
+	KeyRight:             "Right",
+	KeyDown:              "Down",
+	KeySelect:            "Select",
+	KeyPrint:             "Print",
+	KeyExecute:           "Execute",
+	KeySnapshot:          "Snapshot",
+	KeyInsert:            "Insert",
+	KeyDelete:            "Delete",
+	KeyHelp:              "Help",
+	Key0:                 "0",
+	Key1:                 "1",
+	Key2:                 "2",
+	Key3:                 "3",
+	Key4:                 "4",
+	Key5:                 "5",
+	Key6:                 "6",
+	Key7:                 "7",
+	Key8:                 "8",
+	Key9:                 "9",
+	KeyA:                 "A",
+	KeyB:                 "B",
+	KeyC:                 "C",
+	KeyD:                 "D",
+	KeyE:                 "E",
+	KeyF:                 "F",
+	KeyG:                 "G",
+	KeyH:                 "H",
+	KeyI:                 "I",
+	KeyJ:                 "J",
+	KeyK:                 "K",
+	KeyL:                 "L",
+	KeyM:                 "M",
+	KeyN:                 "N",
+	KeyO:                 "O",
+	KeyP:                 "P",
+	KeyQ:                 "Q",
+	KeyR:                 "R",
+	KeyS:                 "S",
+	KeyT:                 "T",
+	KeyU:                 "U",
+	KeyV:                 "V",
+	KeyW:                 "W",
+	KeyX:                 "X",
+	KeyY:                 "Y",
+	KeyZ:                 "Z",
+	KeyLWIN:              "LWIN",
+	KeyRWIN:              "RWIN",
+	KeyApps:              "Apps",
+	KeySleep:             "Sleep",
+	KeyNumpad0:           "Numpad0",
+	KeyNumpad1:           "Numpad1",
+	KeyNumpad2:           "Numpad2",
+	KeyNumpad3:           "Numpad3",
+	KeyNumpad4:           "Numpad4",
+	KeyNumpad5:           "Numpad5",
+	KeyNumpad6:           "Numpad6",
+	KeyNumpad7:           "Numpad7",
+	KeyNumpad8:           "Numpad8",
+	KeyNumpad9:           "Numpad9",
+	KeyMultiply:          "Multiply",
+	KeyAdd:               "Add",
+	KeySeparator:         "Separator",
+	KeySubtract:          "Subtract",
+	KeyDecimal:           "Decimal",
+	KeyDivide:            "Divide",
+	KeyF1:                "F1",
+	KeyF2:                "F2",
+	KeyF3:                "F3",
+	KeyF4:                "F4",
+	KeyF5:                "F5",
+	KeyF6:                "F6",
+	KeyF7:                "F7",
+	KeyF8:                "F8",
+	KeyF9:                "F9",
+	KeyF10:               "F10",
+	KeyF11:               "F11",
+	KeyF12:               "F12",
+	KeyF13:               "F13",
+	KeyF14:               "F14",
+	KeyF15:               "F15",
+	KeyF16:               "F16",
+	KeyF17:               "F17",
+	KeyF18:               "F18",
+	KeyF19:               "F19",
+	KeyF20:               "F20",
+	KeyF21:               "F21",
+	KeyF22:               "F22",
+	KeyF23:               "F23",
+	KeyF24:               "F24",
+	KeyNumlock:           "Numlock",
+	KeyScroll:            "Scroll",
+	KeyLShift:            "LShift",
+	KeyRShift:            "RShift",
+	KeyLControl:          "LControl",
+	KeyRControl:          "RControl",
+	KeyLMenu:             "LMenu",
+	KeyRMenu:             "RMenu",
+	KeyBrowserBack:       "BrowserBack",
+	KeyBrowserForward:    "BrowserForward",
+	KeyBrowserRefresh:    "BrowserRefresh",
+	KeyBrowserStop:       "BrowserStop",
+	KeyBrowserSearch:     "BrowserSearch",
+	KeyBrowserFavorites:  "BrowserFavorites",
+	KeyBrowserHome:       "BrowserHome",
+	KeyVolumeMute:        "VolumeMute",
+	KeyVolumeDown:        "VolumeDown",
+	KeyVolumeUp:          "VolumeUp",
+	KeyMediaNextTrack:    "MediaNextTrack",
+	KeyMediaPrevTrack:    "MediaPrevTrack",
+	KeyMediaStop:         "MediaStop",
+	KeyMediaPlayPause:    "MediaPlayPause",
+	KeyLaunchMail:        "LaunchMail",
+	KeyLaunchMediaSelect: "LaunchMediaSelect",
+	KeyLaunchApp1:        "LaunchApp1",
+	KeyLaunchApp2:        "LaunchApp2",
+	KeyOEM1:              "OEM1",
+	KeyOEMPlus:           "OEMPlus",
+	KeyOEMComma:          "OEMComma",
+	KeyOEMMinus:          "OEMMinus",
+	KeyOEMPeriod:         "OEMPeriod",
+	KeyOEM2:              "OEM2",
+	KeyOEM3:              "OEM3",
+	KeyOEM4:              "OEM4",
+	KeyOEM5:              "OEM5",
+	KeyOEM6:              "OEM6",
+	KeyOEM7:              "OEM7",
+	KeyOEM8:              "OEM8",
+	KeyOEM102:            "OEM102",
+	KeyProcessKey:        "ProcessKey",
+	KeyPacket:            "Packet",
+	KeyAttn:              "Attn",
+	KeyCRSel:             "CRSel",
+	KeyEXSel:             "EXSel",
+	KeyErEOF:             "ErEOF",
+	KeyPlay:              "Play",
+	KeyZoom:              "Zoom",
+	KeyNoName:            "NoName",
+	KeyPA1:               "PA1",
+	KeyOEMClear:          "OEMClear",
+}
+
+type Modifiers byte
+
+func (m Modifiers) String() string {
+	var names []string
+	for _, modifier := range modifierNames {
+		if m&modifier.mod != 0 {
+			names = append(names, modifier.name)
+		}
+	}
+	return strings.Join(names, "+")
+}
+
+// DisplayName returns the modifier names in the language of the keyboard layout.
+func (m Modifiers) DisplayName() string {
+	var names []string
+	for _, modifier := range modifierNames {
+		if m&modifier.mod == 0 {
+			continue
+		}
+		// Windows names the Windows keys left and right.
+		if modifier.mod == ModWin {
+			names = append(names, modifier.name)
+		} else {
+			names = append(names, modifier.key.DisplayName())
+		}
+	}
+	return strings.Join(names, "+")
+}
+
+// Modifiers in the order they are written.
+var modifierNames = []struct {
+	mod  Modifiers
+	key  Key
+	name string
+}{
+	{ModControl, KeyControl, "Ctrl"},
+	{ModAlt, KeyAlt, "Alt"},
+	{ModShift, KeyShift, "Shift"},
+	{ModWin, KeyLWIN, "Win"},
+}
+
+const (
+	ModShift Modifiers = 1 << iota
+	ModControl
+	ModAlt
+	ModWin
+)
+
+type Shortcut struct {
+	Modifiers Modifiers
+	Key       Key
+}
+
+func (s Shortcut) String() string {
+	m := s.Modifiers.String()
+	if m == "" {
+		return s.Key.String()
+	}
+
+	b := new(bytes.Buffer)
+
+	b.WriteString(m)
+	b.WriteRune('+')
+	b.WriteString(s.Key.String())
+
+	return b.String()
+}
+
+// DisplayName returns the shortcut in the language of the keyboard layout, for menus and tooltips.
+func (s Shortcut) DisplayName() string {
+	if s.Modifiers == 0 {
+		return s.Key.DisplayName()
+	}
+	return s.Modifiers.DisplayName() + "+" + s.Key.DisplayName()
+}
@@ -0,0 +1,326 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+// Package core is the part of windigo that does not call Windows: layout,
+// parsing, data binding and the like. Package windigo re-exports it, so
+// applications do not import it; it is kept apart to be tested on any platform.
+package core
+
+// LayoutNode is the geometry a layout manager reads and writes.
+// It does not depend on a window handle, so layouts can be computed off-screen.
+type LayoutNode interface {
+	Pos() (x, y int)
+	Width() int
+	Height() int
+	Visible() bool
+
+	MarginTop() int
+	MarginBtm() int
+	MarginLeft() int
+	MarginRight() int
+
+	SetPos(x, y int)
+	SetSize(width, height int)
+}
+
+// LayoutContainer is the area a layout manager arranges its nodes in.
+type LayoutContainer interface {
+	ClientWidth() int
+	ClientHeight() int
+}
+
+// Constrained nodes limit the size a layout manager may give them.
+// Zero means unconstrained.
+type Constrained interface {
+	MinSize() (width, height int)
+	MaxSize() (width, height int)
+}
+
+// clampNode limits width and height to the node constraints, if it has any.
+func clampNode(node LayoutNode, width, height int) (int, int) {
+	constrained, ok := node.(Constrained)
+	if !ok {
+		return width, height
+	}
+	minWidth, minHeight := constrained.MinSize()
+	maxWidth, maxHeight := constrained.MaxSize()
+	if minWidth != 0 {
+		width = max(width, minWidth)
+	}
+	if maxWidth != 0 {
+		width = min(width, maxWidth)
+	}
+	if minHeight != 0 {
+		height = max(height, minHeight)
+	}
+	if maxHeight != 0 {
+		height = min(height, maxHeight)
+	}
+	return width, height
+}
+
+// SizeHinter nodes report the size they would like to have.
+type SizeHinter interface {
+	PreferredSize() (width, height int)
+	MinimumSize() (width, height int)
+}
+
+// nodeSize is the size a layout manager starts from.
+// With sizeToContent it is the preferred size of nodes that hint one.
+func nodeSize(node LayoutNode, sizeToContent bool) (int, int) {
+	if hinter, ok := node.(SizeHinter); ok && sizeToContent {
+		return hinter.PreferredSize()
+	}
+	return node.Width(), node.Height()
+}
+
+// nodeMinimumSize is the smallest size a layout manager should give node.
+func nodeMinimumSize(node LayoutNode, sizeToContent bool) (int, int) {
+	if hinter, ok := node.(SizeHinter); ok && sizeToContent {
+		return hinter.MinimumSize()
+	}
+	return 0, 0
+}
+
+// Alignment of a node within the space a layout manager gives it.
+type Alignment int
+
+const (
+	AlignStretch Alignment = iota
+	AlignStart
+	AlignCenter
+	AlignEnd
+)
+
+// align returns offset and size of an item of size within space.
+func (alignment Alignment) align(size, space int) (int, int) {
+	switch alignment {
+	case AlignStretch:
+		return 0, space
+	case AlignCenter:
+		return (space - size) / 2, size
+	case AlignEnd:
+		return space - size, size
+	}
+	return 0, size
+}
+
+// layoutPadding implements the Padded methods of layout managers.
+type layoutPadding struct {
+	padding_top, padding_btm,
+	padding_left, padding_right int
+}
+
+func (control *layoutPadding) PaddingTop() int {
+	return control.padding_top
+}
+
+func (control *layoutPadding) PaddingBtm() int {
+	return control.padding_btm
+}
+func (control *layoutPadding) PaddingLeft() int {
+	return control.padding_left
+}
+func (control *layoutPadding) PaddingRight() int {
+	return control.padding_right
+}
+
+func (control *layoutPadding) SetPaddingsAll(padding int) {
+	control.padding_top = padding
+	control.padding_right = padding
+	control.padding_btm = padding
+	control.padding_left = padding
+}
+
+func (control *layoutPadding) SetPaddingsHV(padding_horizontal, padding_vertical int) {
+	control.padding_top = padding_vertical
+	control.padding_right = padding_horizontal
+	control.padding_btm = padding_vertical
+	control.padding_left = padding_horizontal
+}
+
+func (control *layoutPadding) SetPaddings(padding_left, padding_top, padding_right, padding_btm int) {
+	control.padding_top = padding_top
+	control.padding_right = padding_right
+	control.padding_btm = padding_btm
+	control.padding_left = padding_left
+}
+
+func (control *layoutPadding) SetPaddingTop(padding int) {
+	control.padding_top = padding
+}
+
+func (control *layoutPadding) SetPaddingBtm(padding int) {
+	control.padding_btm = padding
+}
+
+func (control *layoutPadding) SetPaddingLeft(padding int) {
+	control.padding_left = padding
+}
+
+func (control *layoutPadding) SetPaddingRight(padding int) {
+	control.padding_right = padding
+}
+
+// LayoutBox is a LayoutNode without a window.
+// It records the geometry assigned by a layout manager.
+type LayoutBox struct {
+	x, y, width, height int
+	hidden              bool
+
+	minWidth, minHeight int
+	maxWidth, maxHeight int
+
+	margin_top, margin_btm,
+	margin_left, margin_right int
+}
+
+func NewLayoutBox(width, height int) *LayoutBox {
+	return &LayoutBox{width: width, height: height}
+}
+
+func (box *LayoutBox) Pos() (x, y int) {
+	return box.x, box.y
+}
+
+func (box *LayoutBox) Size() (width, height int) {
+	return box.width, box.height
+}
+
+func (box *LayoutBox) Width() int {
+	return box.width
+}
+
+func (box *LayoutBox) Height() int {
+	return box.height
+}
+
+func (box *LayoutBox) Visible() bool {
+	return !box.hidden
+}
+
+func (box *LayoutBox) Show() {
+	box.hidden = false
+}
+
+func (box *LayoutBox) Hide() {
+	box.hidden = true
+}
+
+func (box *LayoutBox) SetPos(x, y int) {
+	box.x, box.y = x, y
+}
+
+func (box *LayoutBox) MinSize() (width, height int) {
+	return box.minWidth, box.minHeight
+}
+
+func (box *LayoutBox) MaxSize() (width, height int) {
+	return box.maxWidth, box.maxHeight
+}
+
+func (box *LayoutBox) clampSize(width, height int) (int, int) {
+	if box.minWidth != 0 {
+		width = max(width, box.minWidth)
+	}
+	if box.maxWidth != 0 {
+		width = min(width, box.maxWidth)
+	}
+	if box.minHeight != 0 {
+		height = max(height, box.minHeight)
+	}
+	if box.maxHeight != 0 {
+		height = min(height, box.maxHeight)
+	}
+	return width, height
+}
+
+func (box *LayoutBox) SetSize(width, height int) {
+	box.width, box.height = box.clampSize(width, height)
+}
+
+func (box *LayoutBox) SetMinSize(width, height int) {
+	box.minWidth = width
+	box.minHeight = height
+	box.width, box.height = box.clampSize(box.width, box.height)
+}
+
+func (box *LayoutBox) SetMaxSize(width, height int) {
+	box.maxWidth = width
+	box.maxHeight = height
+	box.width, box.height = box.clampSize(box.width, box.height)
+}
+
+// Marginal
+func (box *LayoutBox) MarginTop() int {
+	return box.margin_top
+}
+
+func (box *LayoutBox) MarginBtm() int {
+	return box.margin_btm
+}
+func (box *LayoutBox) MarginLeft() int {
+	return box.margin_left
+}
+func (box *LayoutBox) MarginRight() int {
+	return box.margin_right
+}
+
+func (box *LayoutBox) SetMarginsAll(margin int) {
+	box.margin_top = margin
+	box.margin_right = margin
+	box.margin_btm = margin
+	box.margin_left = margin
+}
+
+func (box *LayoutBox) SetMarginsHV(margin_horizontal, margin_vertical int) {
+	box.margin_top = margin_vertical
+	box.margin_right = margin_horizontal
+	box.margin_btm = margin_vertical
+	box.margin_left = margin_horizontal
+}
+
+func (box *LayoutBox) SetMargins(margin_left, margin_top, margin_right, margin_btm int) {
+	box.margin_top = margin_top
+	box.margin_right = margin_right
+	box.margin_btm = margin_btm
+	box.margin_left = margin_left
+}
+
+func (box *LayoutBox) SetMarginTop(margin int) {
+	box.margin_top = margin
+}
+
+func (box *LayoutBox) SetMarginBtm(margin int) {
+	box.margin_btm = margin
+}
+
+func (box *LayoutBox) SetMarginLeft(margin int) {
+	box.margin_left = margin
+}
+
+func (box *LayoutBox) SetMarginRight(margin int) {
+	box.margin_right = margin
+}
+
+// LayoutArea is a LayoutContainer without a window.
+type LayoutArea struct {
+	width, height int
+}
+
+func NewLayoutArea(width, height int) *LayoutArea {
+	return &LayoutArea{width, height}
+}
+
+func (area *LayoutArea) ClientWidth() int {
+	return area.width
+}
+
+func (area *LayoutArea) ClientHeight() int {
+	return area.height
+}
+
+func (area *LayoutArea) SetSize(width, height int) {
+	area.width, area.height = width, height
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// LayoutStateVersion is the schema version written by SimpleDock.SaveState.
+// Version 0 is the original format that listed controls by index.
+const LayoutStateVersion = 1
+
+// NodeState is the saved state of one docked control.
+type NodeState struct {
+	X, Y, Width, Height int
+
+	Split    int                   `json:",omitempty"` // resizer: size of the first control
+	Current  int                   `json:",omitempty"` // tab view: current page
+	Columns  []int                 `json:",omitempty"` // list view: column widths
+	Children map[string]*NodeState `json:",omitempty"` // nested docks and tab pages
+}
+
+// DockState gets saved and loaded from json.
+type DockState struct {
+	Version     int
+	WindowState string `json:",omitempty"`
+	Controls    map[string]*NodeState
+}
+
+// LayoutMigration upgrades state saved with one schema version to the next.
+type LayoutMigration func(state *DockState) error
+
+var layoutMigrations = map[int]LayoutMigration{}
+
+// RegisterLayoutMigration sets the migration run on state saved with version from.
+// Migrations run in order until the state reaches LayoutStateVersion.
+func RegisterLayoutMigration(from int, migrate LayoutMigration) {
+	layoutMigrations[from] = migrate
+}
+
+// CtlState is a control in the version 0 format.
+type CtlState struct {
+	X, Y, Width, Height int
+}
+
+// layoutStateFile accepts every schema version; Controls was a list before version 1.
+type layoutStateFile struct {
+	Version     int
+	WindowState string
+	Controls    json.RawMessage
+}
+
+// DecodeDockState parses data and migrates it to LayoutStateVersion.
+// Version 0 controls are only kept if there are count of them, as their index is all that identifies them.
+func DecodeDockState(data []byte, count int) (*DockState, error) {
+	return decodeDockState(data, count, LayoutStateVersion, layoutMigrations)
+}
+
+func decodeDockState(data []byte, count, version int, migrations map[int]LayoutMigration) (*DockState, error) {
+	var file layoutStateFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	if file.Version > version {
+		return nil, fmt.Errorf("windigo: layout state version %d is newer than %d", file.Version, version)
+	}
+
+	state := &DockState{Version: file.Version, WindowState: file.WindowState, Controls: map[string]*NodeState{}}
+	if file.Version == 0 {
+		var controls []*CtlState
+		if len(file.Controls) > 0 {
+			if err := json.Unmarshal(file.Controls, &controls); err != nil {
+				return nil, err
+			}
+		}
+		// if number of controls in the saved layout does not match
+		// current number on screen - something changed and we do not reload
+		// rest of control sizes from json
+		if len(controls) != count {
+			controls = nil
+		}
+		for i, ctl := range controls {
+			if ctl != nil {
+				state.Controls[IndexKey(i)] = &NodeState{X: ctl.X, Y: ctl.Y, Width: ctl.Width, Height: ctl.Height}
+			}
+		}
+	} else if len(file.Controls) > 0 {
+		if err := json.Unmarshal(file.Controls, &state.Controls); err != nil {
+			return nil, err
+		}
+	}
+
+	for state.Version < version {
+		if migrate := migrations[state.Version]; migrate != nil {
+			if err := migrate(state); err != nil {
+				return nil, err
+			}
+		}
+		state.Version++
+	}
+	return state, nil
+}
+
+// IndexKey names controls saved without a name.
+func IndexKey(i int) string {
+	return "#" + strconv.Itoa(i)
+}
+
+// CheckStateName reports whether name may identify a control in saved state next to used.
+// Names starting with '#' are reserved for IndexKey; the empty name means unnamed.
+func CheckStateName(name string, used []string) error {
+	if strings.HasPrefix(name, "#") {
+		return fmt.Errorf("windigo: state name %q is reserved for unnamed controls", name)
+	}
+	if name == "" {
+		return nil
+	}
+	for _, u := range used {
+		if u == name {
+			return fmt.Errorf("windigo: state name %q is already used", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDecodeDockState(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		count   int
+		want    map[string]int // control key and its width
+		wantErr bool
+	}{
+		{"version 0", `{"WindowState":"ws","Controls":[{"Width":10},{"Width":20}]}`, 2, map[string]int{"#0": 10, "#1": 20}, false},
+		{"version 0 count changed", `{"Controls":[{"Width":10},{"Width":20}]}`, 3, map[string]int{}, false},
+		{"version 0 without controls", `{}`, 0, map[string]int{}, false},
+		{"version 1", `{"Version":1,"Controls":{"main":{"Width":30},"#1":{"Width":40}}}`, 5, map[string]int{"main": 30, "#1": 40}, false},
+		{"newer version", `{"Version":2,"Controls":{}}`, 0, nil, true},
+		{"bad json", `{"Controls":`, 0, nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			state, err := DecodeDockState([]byte(test.data), test.count)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("error = %v, want error %v", err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if state.Version != LayoutStateVersion {
+				t.Errorf("Version = %d, want %d", state.Version, LayoutStateVersion)
+			}
+			if len(state.Controls) != len(test.want) {
+				t.Errorf("Controls = %v, want %v", state.Controls, test.want)
+			}
+			for key, width := range test.want {
+				if ctl := state.Controls[key]; ctl == nil || ctl.Width != width {
+					t.Errorf("Controls[%q] = %v, want width %d", key, ctl, width)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeDockStateMigrations(t *testing.T) {
+	// Version 0 state runs every migration in order up to version 3,
+	// a missing migration leaves the state as it is.
+	var ran []int
+	migrations := map[int]LayoutMigration{
+		0: func(state *DockState) error {
+			ran = append(ran, state.Version)
+			state.Controls["main"] = state.Controls["#0"]
+			delete(state.Controls, "#0")
+			return nil
+		},
+		2: func(state *DockState) error {
+			ran = append(ran, state.Version)
+			state.Controls["main"].Width *= 2
+			return nil
+		},
+	}
+	state, err := decodeDockState([]byte(`{"Controls":[{"Width":10}]}`), 1, 3, migrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ran) != "[0 2]" {
+		t.Errorf("migrations ran from versions %v, want [0 2]", ran)
+	}
+	if state.Version != 3 {
+		t.Errorf("Version = %d, want 3", state.Version)
+	}
+	if ctl := state.Controls["main"]; ctl == nil || ctl.Width != 20 || len(state.Controls) != 1 {
+		t.Errorf("Controls = %v, want main with width 20", state.Controls)
+	}
+
+	// Version 2 state only runs the migration from 2.
+	ran = nil
+	if _, err := decodeDockState([]byte(`{"Version":2,"Controls":{"main":{"Width":10}}}`), 0, 3, migrations); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(ran) != "[2]" {
+		t.Errorf("migrations ran from versions %v, want [2]", ran)
+	}
+
+	// A failing migration fails the decode.
+	failing := map[int]LayoutMigration{1: func(*DockState) error { return fmt.Errorf("no") }}
+	if _, err := decodeDockState([]byte(`{}`), 0, 2, failing); err == nil {
+		t.Error("failing migration: no error")
+	}
+}
+
+func TestCheckStateName(t *testing.T) {
+	used := []string{"", "main", "tools"}
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"", false},
+		{"side", false},
+		{"main", true},
+		{"tools", true},
+		{"#0", true},
+		{"#side", true},
+		{"side#1", false},
+	}
+	for _, test := range tests {
+		if err := CheckStateName(test.name, used); (err != nil) != test.wantErr {
+			t.Errorf("CheckStateName(%q) = %v, want error %v", test.name, err, test.wantErr)
+		}
+	}
+}
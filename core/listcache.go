@@ -0,0 +1,164 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ListItem represents an item in a ListView widget.
+type ListItem interface {
+	Text() []string  // Text returns the text of the multi-column item.
+	ImageIndex() int // ImageIndex is used only if SetImageList is called on the listview
+}
+
+// ListDataSource supplies the items of a virtual ListView.
+type ListDataSource interface {
+	Count() int
+	Item(index int) ListItem
+}
+
+// IndexedDataSource is a ListDataSource that finds the index of its items
+// itself, typically from a key kept with the item, so it is not scanned.
+type IndexedDataSource interface {
+	ListDataSource
+	IndexOf(item ListItem) int
+}
+
+// EditableDataSource is a data source a virtual ListView can insert items
+// into and delete items from.
+type EditableDataSource interface {
+	IndexedDataSource
+	Insert(item ListItem, index int) error
+	Delete(index int) error
+}
+
+// ListItemCache keeps the range of items a virtual ListView is about to draw,
+// so the data source is asked for each item once per screen.
+type ListItemCache struct {
+	source  ListDataSource
+	from    int
+	items   []ListItem
+	maxSize int
+}
+
+// NewListItemCache caches at most maxSize items of source.
+func NewListItemCache(source ListDataSource, maxSize int) *ListItemCache {
+	return &ListItemCache{source: source, maxSize: max(maxSize, 1)}
+}
+
+// Source returns the data source of the cache.
+func (cache *ListItemCache) Source() ListDataSource {
+	return cache.source
+}
+
+// Count returns the number of items in the data source.
+func (cache *ListItemCache) Count() int {
+	return cache.source.Count()
+}
+
+// Hint loads items from through to, inclusive, unless they are cached already.
+func (cache *ListItemCache) Hint(from, to int) {
+	from = max(from, 0)
+	to = min(min(to, cache.source.Count()-1), from+cache.maxSize-1)
+	if to < from {
+		return
+	}
+	if from >= cache.from && to < cache.from+len(cache.items) {
+		return
+	}
+
+	items := make([]ListItem, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		// Reuse what is cached already when the range scrolls.
+		if item, ok := cache.cached(i); ok {
+			items = append(items, item)
+		} else {
+			items = append(items, cache.source.Item(i))
+		}
+	}
+	cache.from, cache.items = from, items
+}
+
+func (cache *ListItemCache) cached(index int) (ListItem, bool) {
+	if index >= cache.from && index < cache.from+len(cache.items) {
+		return cache.items[index-cache.from], true
+	}
+	return nil, false
+}
+
+// Item at index, from the cache if possible. Returns nil if index is out of range.
+func (cache *ListItemCache) Item(index int) ListItem {
+	if item, ok := cache.cached(index); ok {
+		return item
+	}
+	if index < 0 || index >= cache.source.Count() {
+		return nil
+	}
+	return cache.source.Item(index)
+}
+
+// Invalidate drops the cached items, after the data source changed.
+func (cache *ListItemCache) Invalidate() {
+	cache.from, cache.items = 0, nil
+}
+
+// IndexOf returns the index of item, or -1. An IndexedDataSource is asked,
+// other sources are only searched within the cached items.
+func (cache *ListItemCache) IndexOf(item ListItem) int {
+	if indexed, ok := cache.source.(IndexedDataSource); ok {
+		return indexed.IndexOf(item)
+	}
+	for i, cached := range cache.items {
+		if cached == item {
+			return cache.from + i
+		}
+	}
+	return -1
+}
+
+// Find returns the index of the first item whose first column equals text,
+// or starts with it if partial, ignoring case. The search begins at start and
+// continues from the top if wrap. Returns -1 if there is no match.
+func (cache *ListItemCache) Find(text string, start int, partial, wrap bool) int {
+	count := cache.source.Count()
+	if count == 0 {
+		return -1
+	}
+	start = min(max(start, 0), count)
+	n := count - start
+	if wrap {
+		n = count
+	}
+	for k := range n {
+		i := (start + k) % count
+		item := cache.Item(i)
+		if item == nil {
+			continue
+		}
+		columns := item.Text()
+		if len(columns) == 0 {
+			continue
+		}
+		if partial && hasPrefixFold(columns[0], text) || !partial && strings.EqualFold(columns[0], text) {
+			return i
+		}
+	}
+	return -1
+}
+
+// hasPrefixFold reports whether s starts with prefix, ignoring case.
+// It compares rune by rune, as case folding may change the length in bytes.
+func hasPrefixFold(s, prefix string) bool {
+	for _, r := range prefix {
+		c, size := utf8.DecodeRuneInString(s)
+		if size == 0 || !strings.EqualFold(string(c), string(r)) {
+			return false
+		}
+		s = s[size:]
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import "testing"
+
+type fakeItem struct {
+	text string
+	more []string // columns after the first
+}
+
+func (item *fakeItem) Text() []string  { return append([]string{item.text}, item.more...) }
+func (item *fakeItem) ImageIndex() int { return -1 }
+
+// fakeSource counts the items it is asked for.
+type fakeSource struct {
+	items []ListItem
+	asked int
+}
+
+func newFakeSource(texts ...string) *fakeSource {
+	source := &fakeSource{}
+	for _, text := range texts {
+		source.items = append(source.items, &fakeItem{text: text})
+	}
+	return source
+}
+
+func (source *fakeSource) Count() int { return len(source.items) }
+
+func (source *fakeSource) Item(index int) ListItem {
+	source.asked++
+	return source.items[index]
+}
+
+// indexedSource finds items without a scan.
+type indexedSource struct {
+	*fakeSource
+}
+
+func (source indexedSource) IndexOf(item ListItem) int {
+	for i, it := range source.items {
+		if it.Text()[0] == item.Text()[0] {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestListItemCacheHint(t *testing.T) {
+	source := newFakeSource("a", "b", "c", "d", "e", "f", "g", "h")
+	cache := NewListItemCache(source, 4)
+
+	tests := []struct {
+		name     string
+		from, to int
+		asked    int // items asked of the source by this hint
+	}{
+		{"first screen", 0, 2, 3},
+		{"same screen", 0, 2, 0},
+		{"within the cache", 1, 2, 0},
+		{"scrolled by one", 1, 3, 1},
+		{"limited to the cache size", 2, 7, 2},
+		{"past the end", 6, 20, 2},
+		{"empty range", 5, 4, 0},
+	}
+	for _, test := range tests {
+		source.asked = 0
+		cache.Hint(test.from, test.to)
+		if source.asked != test.asked {
+			t.Errorf("%s: Hint(%d, %d) asked %d items, want %d", test.name, test.from, test.to, source.asked, test.asked)
+		}
+	}
+
+	source.asked = 0
+	if item := cache.Item(7); item.Text()[0] != "h" || source.asked != 0 {
+		t.Errorf("Item(7) = %v, asked %d, want h from the cache", item, source.asked)
+	}
+	if item := cache.Item(0); item.Text()[0] != "a" || source.asked != 1 {
+		t.Errorf("Item(0) = %v, asked %d, want a from the source", item, source.asked)
+	}
+	for _, index := range []int{-1, 8} {
+		if item := cache.Item(index); item != nil {
+			t.Errorf("Item(%d) = %v, want nil", index, item)
+		}
+	}
+
+	cache.Invalidate()
+	source.asked = 0
+	cache.Item(7)
+	if source.asked != 1 {
+		t.Errorf("Item after Invalidate asked %d items, want 1", source.asked)
+	}
+}
+
+func TestListItemCacheIndexOf(t *testing.T) {
+	plain := newFakeSource("a", "b", "c", "d")
+	cache := NewListItemCache(plain, 2)
+	cache.Hint(2, 3)
+	if got := cache.IndexOf(plain.items[3]); got != 3 {
+		t.Errorf("IndexOf a cached item = %d, want 3", got)
+	}
+	if got := cache.IndexOf(plain.items[0]); got != -1 {
+		t.Errorf("IndexOf an item out of the cache = %d, want -1 without an IndexedDataSource", got)
+	}
+
+	indexed := indexedSource{newFakeSource("a", "b", "c", "d")}
+	cache = NewListItemCache(indexed, 2)
+	if got := cache.IndexOf(&fakeItem{text: "a"}); got != 0 {
+		t.Errorf("IndexOf with an IndexedDataSource = %d, want 0", got)
+	}
+	if indexed.asked != 0 {
+		t.Errorf("IndexOf asked %d items of an IndexedDataSource", indexed.asked)
+	}
+}
+
+func TestListItemCacheFind(t *testing.T) {
+	cache := NewListItemCache(newFakeSource("Apple", "banana", "Cherry", "apricot", "\u212Aelvin", "Ärger", "ab"), 10)
+	tests := []struct {
+		text    string
+		start   int
+		partial bool
+		wrap    bool
+		want    int
+	}{
+		{"banana", 0, false, false, 1},
+		{"BANANA", 0, false, false, 1},
+		{"ban", 0, false, false, -1},
+		{"ap", 0, true, false, 0},
+		{"ap", 1, true, false, 3},
+		{"ch", 3, true, false, -1},
+		{"ch", 3, true, true, 2},
+		{"apple", 4, false, true, 0},
+		{"", 2, true, false, 2},
+		{"zebra", 0, true, true, -1},
+		{"k", 0, true, false, 4}, // KELVIN SIGN folds to k but is 3 bytes long
+		{"KELV", 0, true, false, 4},
+		{"är", 0, true, false, 5},
+		{"abc", 6, true, false, -1}, // prefix longer than the text
+	}
+	for _, test := range tests {
+		if got := cache.Find(test.text, test.start, test.partial, test.wrap); got != test.want {
+			t.Errorf("Find(%q, %d, partial %v, wrap %v) = %d, want %d", test.text, test.start, test.partial, test.wrap, got, test.want)
+		}
+	}
+	if got := NewListItemCache(newFakeSource(), 10).Find("a", 0, true, true); got != -1 {
+		t.Errorf("Find in an empty source = %d, want -1", got)
+	}
+}
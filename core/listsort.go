@@ -0,0 +1,220 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ColumnComparator compares the text of two cells of a column,
+// returning a negative number, zero or a positive number like strings.Compare.
+// It returns SortLast if only b has a value, or -SortLast if only a has.
+type ColumnComparator func(a, b string) int
+
+// SortLast is returned by a ColumnComparator when one of the cells has no value,
+// such as text in a column of numbers. Cells without a value sort after all values
+// in descending order too.
+const SortLast = math.MaxInt
+
+// CompareText compares text ignoring case. It is the default comparator.
+func CompareText(a, b string) int {
+	if c := strings.Compare(strings.ToLower(a), strings.ToLower(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// CompareNatural compares text ignoring case, with runs of digits compared
+// by their value, so "file2" sorts before "file10".
+func CompareNatural(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if isDigit(a[i]) && isDigit(b[j]) {
+			ei, ej := digitsEnd(a, i), digitsEnd(b, j)
+			if c := compareDigits(a[i:ei], b[j:ej]); c != 0 {
+				return c
+			}
+			i, j = ei, ej
+			continue
+		}
+		ra, na := utf8.DecodeRuneInString(a[i:])
+		rb, nb := utf8.DecodeRuneInString(b[j:])
+		if c := cmp.Compare(unicode.ToLower(ra), unicode.ToLower(rb)); c != 0 {
+			return c
+		}
+		i, j = i+na, j+nb
+	}
+	if c := cmp.Compare(len(a)-i, len(b)-j); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func digitsEnd(s string, i int) int {
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return i
+}
+
+// compareDigits compares two runs of digits by value, then by length,
+// so "01" sorts after "1".
+func compareDigits(a, b string) int {
+	ta, tb := strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if c := cmp.Compare(len(ta), len(tb)); c != 0 {
+		return c
+	}
+	if c := strings.Compare(ta, tb); c != 0 {
+		return c
+	}
+	return cmp.Compare(len(a), len(b))
+}
+
+// CompareNumeric compares cells as numbers. Cells that are not numbers
+// sort after all numbers, in text order.
+func CompareNumeric(a, b string) int {
+	fa, errA := strconv.ParseFloat(strings.TrimSpace(a), 64)
+	fb, errB := strconv.ParseFloat(strings.TrimSpace(b), 64)
+	switch {
+	case errA == nil && errB == nil:
+		return cmp.Compare(fa, fb)
+	case errA == nil:
+		return -SortLast
+	case errB == nil:
+		return SortLast
+	}
+	return CompareText(a, b)
+}
+
+// CompareDate compares cells as times in layout, see time.Parse.
+// Cells that do not parse sort after all times, in text order.
+func CompareDate(layout string) ColumnComparator {
+	return func(a, b string) int {
+		ta, errA := time.Parse(layout, strings.TrimSpace(a))
+		tb, errB := time.Parse(layout, strings.TrimSpace(b))
+		switch {
+		case errA == nil && errB == nil:
+			return ta.Compare(tb)
+		case errA == nil:
+			return -SortLast
+		case errB == nil:
+			return SortLast
+		}
+		return CompareText(a, b)
+	}
+}
+
+// SortKey is a column to sort by.
+type SortKey struct {
+	Column     int
+	Descending bool
+}
+
+// ListSorter orders list items by a list of sort keys, the first key
+// deciding first. Sorting is stable, so rows equal on all keys keep their order.
+type ListSorter struct {
+	comparators map[int]ColumnComparator
+	keys        []SortKey
+}
+
+// SetComparator sets the comparator of column col. A nil comparator restores CompareText.
+func (sorter *ListSorter) SetComparator(col int, compare ColumnComparator) {
+	if sorter.comparators == nil {
+		sorter.comparators = map[int]ColumnComparator{}
+	}
+	if compare == nil {
+		delete(sorter.comparators, col)
+	} else {
+		sorter.comparators[col] = compare
+	}
+}
+
+// Comparator of column col.
+func (sorter *ListSorter) Comparator(col int) ColumnComparator {
+	if compare, ok := sorter.comparators[col]; ok {
+		return compare
+	}
+	return CompareText
+}
+
+func (sorter *ListSorter) Keys() []SortKey {
+	return slices.Clone(sorter.keys)
+}
+
+func (sorter *ListSorter) SetKeys(keys ...SortKey) {
+	sorter.keys = slices.Clone(keys)
+}
+
+// Click updates the keys for a click on the header of column col.
+// A plain click sorts by col alone, reversing the order if col was already the only key.
+// With add, col is appended as a secondary key, or reversed if it is a key already.
+func (sorter *ListSorter) Click(col int, add bool) {
+	i := slices.IndexFunc(sorter.keys, func(key SortKey) bool { return key.Column == col })
+	switch {
+	case add && i >= 0:
+		sorter.keys[i].Descending = !sorter.keys[i].Descending
+	case add:
+		sorter.keys = append(sorter.keys, SortKey{Column: col})
+	case i == 0 && len(sorter.keys) == 1:
+		sorter.keys[0].Descending = !sorter.keys[0].Descending
+	default:
+		sorter.keys = []SortKey{{Column: col}}
+	}
+}
+
+// Compare two items by the keys. Missing cells sort as empty text.
+// Cells without a value stay last in descending order, see SortLast.
+func (sorter *ListSorter) Compare(a, b ListItem) int {
+	ta, tb := a.Text(), b.Text()
+	for _, key := range sorter.keys {
+		c := sorter.Comparator(key.Column)(Cell(ta, key.Column), Cell(tb, key.Column))
+		if key.Descending && c != SortLast && c != -SortLast {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// Cell returns column col of text, or empty text if the item has no such column.
+func Cell(text []string, col int) string {
+	if col < 0 || col >= len(text) {
+		return ""
+	}
+	return text[col]
+}
+
+// Sort items in place.
+func (sorter *ListSorter) Sort(items []ListItem) {
+	slices.SortStableFunc(items, sorter.Compare)
+}
+
+// Ranks returns the position of each item after sorting, leaving items unchanged.
+func (sorter *ListSorter) Ranks(items []ListItem) []int {
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(i, j int) int { return sorter.Compare(items[i], items[j]) })
+
+	ranks := make([]int, len(items))
+	for rank, i := range order {
+		ranks[i] = rank
+	}
+	return ranks
+}
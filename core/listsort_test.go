@@ -0,0 +1,171 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"slices"
+	"testing"
+)
+
+func sign(c int) int {
+	switch {
+	case c < 0:
+		return -1
+	case c > 0:
+		return 1
+	}
+	return 0
+}
+
+func TestComparators(t *testing.T) {
+	tests := []struct {
+		name    string
+		compare ColumnComparator
+		a, b    string
+		want    int
+	}{
+		{"text", CompareText, "apple", "Banana", -1},
+		{"text ignores case", CompareText, "b", "A", 1},
+		{"text case breaks ties", CompareText, "A", "a", -1},
+		{"text equal", CompareText, "same", "same", 0},
+		{"natural numbers by value", CompareNatural, "file2", "file10", -1},
+		{"natural text first", CompareNatural, "a10", "b2", -1},
+		{"natural leading zeros", CompareNatural, "1", "01", -1},
+		{"natural zeros by value", CompareNatural, "007", "10", -1},
+		{"natural ignores case", CompareNatural, "File2", "file10", -1},
+		{"natural prefix", CompareNatural, "file", "file1", -1},
+		{"natural equal", CompareNatural, "x1y2", "x1y2", 0},
+		{"natural unicode", CompareNatural, "Ébc", "ébd", -1},
+		{"numeric", CompareNumeric, "9", "10", -1},
+		{"numeric floats", CompareNumeric, "-1.5", "-2", 1},
+		{"numeric spaces", CompareNumeric, " 3 ", "3", 0},
+		{"numeric before text", CompareNumeric, "100", "abc", -1},
+		{"text after numeric", CompareNumeric, "abc", "100", 1},
+		{"numeric both text", CompareNumeric, "b", "a", 1},
+		{"date", CompareDate("2006-01-02"), "2024-12-31", "2025-01-01", -1},
+		{"date equal", CompareDate("2006-01-02"), "2025-01-01", " 2025-01-01", 0},
+		{"date before text", CompareDate("2006-01-02"), "2025-01-01", "never", -1},
+		{"text after date", CompareDate("2006-01-02"), "never", "2025-01-01", 1},
+	}
+	for _, test := range tests {
+		if got := sign(test.compare(test.a, test.b)); got != test.want {
+			t.Errorf("%s: compare(%q, %q) = %d, want %d", test.name, test.a, test.b, got, test.want)
+		}
+		if got := sign(test.compare(test.b, test.a)); got != -test.want {
+			t.Errorf("%s: compare(%q, %q) = %d, want %d", test.name, test.b, test.a, got, -test.want)
+		}
+	}
+}
+
+func TestListSorterClick(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []SortKey
+		col  int
+		add  bool
+		want []SortKey
+	}{
+		{"first click", nil, 1, false, []SortKey{{1, false}}},
+		{"click again reverses", []SortKey{{1, false}}, 1, false, []SortKey{{1, true}}},
+		{"other column replaces", []SortKey{{1, true}}, 2, false, []SortKey{{2, false}}},
+		{"plain click on a secondary key", []SortKey{{1, false}, {2, false}}, 2, false, []SortKey{{2, false}}},
+		{"plain click on the primary of many", []SortKey{{1, false}, {2, false}}, 1, false, []SortKey{{1, false}}},
+		{"add appends", []SortKey{{1, false}}, 2, true, []SortKey{{1, false}, {2, false}}},
+		{"add reverses a key", []SortKey{{1, false}, {2, false}}, 2, true, []SortKey{{1, false}, {2, true}}},
+		{"add to nothing", nil, 0, true, []SortKey{{0, false}}},
+	}
+	for _, test := range tests {
+		var sorter ListSorter
+		sorter.SetKeys(test.keys...)
+		sorter.Click(test.col, test.add)
+		if got := sorter.Keys(); !slices.Equal(got, test.want) {
+			t.Errorf("%s: keys %v after Click(%d, %v), want %v", test.name, got, test.col, test.add, test.want)
+		}
+	}
+}
+
+func TestListSorterSort(t *testing.T) {
+	row := func(text ...string) ListItem { return &fakeItem{text: text[0], more: text[1:]} }
+	items := []ListItem{
+		row("b", "10"),
+		row("a", "9"),
+		row("b", "9"),
+		row("a"),
+		row("a", "9"),
+	}
+	names := func(items []ListItem) []string {
+		var names []string
+		for _, item := range items {
+			names = append(names, Cell(item.Text(), 0)+Cell(item.Text(), 1))
+		}
+		return names
+	}
+
+	tests := []struct {
+		name        string
+		keys        []SortKey
+		comparators map[int]ColumnComparator
+		want        []string
+		ranks       []int
+	}{
+		{"no keys keeps the order", nil, nil, []string{"b10", "a9", "b9", "a", "a9"}, []int{0, 1, 2, 3, 4}},
+		{"one key is stable", []SortKey{{0, false}}, nil, []string{"a9", "a", "a9", "b10", "b9"}, []int{3, 0, 4, 1, 2}},
+		{"descending", []SortKey{{0, true}}, nil, []string{"b10", "b9", "a9", "a", "a9"}, []int{0, 2, 1, 3, 4}},
+		{"secondary key, missing cells as empty", []SortKey{{0, false}, {1, false}}, nil, []string{"a", "a9", "a9", "b10", "b9"}, []int{3, 1, 4, 0, 2}},
+		{"numeric secondary key", []SortKey{{0, true}, {1, false}}, map[int]ColumnComparator{1: CompareNumeric}, []string{"b9", "b10", "a9", "a9", "a"}, []int{1, 2, 0, 4, 3}},
+	}
+	for _, test := range tests {
+		var sorter ListSorter
+		sorter.SetKeys(test.keys...)
+		for col, compare := range test.comparators {
+			sorter.SetComparator(col, compare)
+		}
+		if got := sorter.Ranks(items); !slices.Equal(got, test.ranks) {
+			t.Errorf("%s: Ranks = %v, want %v", test.name, got, test.ranks)
+		}
+		sorted := slices.Clone(items)
+		sorter.Sort(sorted)
+		if got := names(sorted); !slices.Equal(got, test.want) {
+			t.Errorf("%s: Sort = %v, want %v", test.name, got, test.want)
+		}
+	}
+
+	var sorter ListSorter
+	sorter.SetComparator(1, CompareNumeric)
+	sorter.SetComparator(1, nil)
+	if got := sorter.Comparator(1)("9", "10"); got <= 0 {
+		t.Errorf("Comparator after SetComparator(nil) = %d, want CompareText", got)
+	}
+}
+
+func TestListSorterDescendingKeepsNoValueLast(t *testing.T) {
+	tests := []struct {
+		name    string
+		compare ColumnComparator
+		cells   []string
+		want    []string
+	}{
+		{"numeric", CompareNumeric, []string{"10", "abc", "2", "", "x", "3.5"}, []string{"10", "3.5", "2", "x", "abc", ""}},
+		{"date", CompareDate("2006-01-02"), []string{"never", "2024-01-01", "", "2025-06-30"}, []string{"2025-06-30", "2024-01-01", "never", ""}},
+	}
+	for _, test := range tests {
+		var items []ListItem
+		for _, cell := range test.cells {
+			items = append(items, &fakeItem{text: cell})
+		}
+		var sorter ListSorter
+		sorter.SetComparator(0, test.compare)
+		sorter.SetKeys(SortKey{Column: 0, Descending: true})
+		sorter.Sort(items)
+
+		var got []string
+		for _, item := range items {
+			got = append(got, item.Text()[0])
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("%s: descending sort = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
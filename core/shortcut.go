@@ -0,0 +1,111 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Other names ParseShortcut accepts for keys, normalized.
+var keyAliases = map[string]Key{
+	"esc":         KeyEscape,
+	"del":         KeyDelete,
+	"ins":         KeyInsert,
+	"pgup":        KeyPrior,
+	"pageup":      KeyPrior,
+	"pgdn":        KeyNext,
+	"pgdown":      KeyNext,
+	"pagedown":    KeyNext,
+	"enter":       KeyReturn,
+	"backspace":   KeyBack,
+	"capslock":    KeyCapital,
+	"scrolllock":  KeyScroll,
+	"printscreen": KeySnapshot,
+	"prtsc":       KeySnapshot,
+	"alt":         KeyAlt,
+	"menu":        KeyAlt,
+	"ctrl":        KeyControl,
+	"win":         KeyLWIN,
+	"+":           KeyOEMPlus,
+	"plus":        KeyOEMPlus,
+	"-":           KeyOEMMinus,
+	"minus":       KeyOEMMinus,
+	",":           KeyOEMComma,
+	"comma":       KeyOEMComma,
+	".":           KeyOEMPeriod,
+	"period":      KeyOEMPeriod,
+}
+
+// Names ParseShortcut accepts for modifiers, normalized.
+var modifierAliases = map[string]Modifiers{
+	"ctrl":    ModControl,
+	"control": ModControl,
+	"alt":     ModAlt,
+	"shift":   ModShift,
+	"win":     ModWin,
+	"windows": ModWin,
+}
+
+// Key names of key2string, normalized. It is built once, so ParseKey may run on any goroutine.
+var string2key = make(map[string]Key, len(key2string))
+
+func init() {
+	for key, name := range key2string {
+		string2key[normalizeKeyName(name)] = key
+	}
+}
+
+// normalizeKeyName makes key names case and space insensitive.
+func normalizeKeyName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// ParseKey returns the key named name, as written by Key.String or an alias such as Esc, Del or PgUp.
+// Names are case insensitive.
+func ParseKey(name string) (Key, error) {
+	normalized := normalizeKeyName(name)
+	if key, ok := string2key[normalized]; ok {
+		return key, nil
+	}
+	if key, ok := keyAliases[normalized]; ok {
+		return key, nil
+	}
+	return 0, fmt.Errorf("unknown key %q", name)
+}
+
+// ParseShortcut parses a shortcut as written by Shortcut.String, like "Ctrl+Alt+F5".
+// Modifiers may be in any order and Win is a modifier. Key names are as for ParseKey,
+// "Ctrl++" is Ctrl with the plus key. An empty string is the zero Shortcut.
+func ParseShortcut(text string) (Shortcut, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return Shortcut{}, nil
+	}
+
+	// The key is after the last +, unless the key is + itself.
+	keyName, modifierNames := text, ""
+	if i := strings.LastIndex(text[:len(text)-1], "+"); i >= 0 {
+		keyName, modifierNames = text[i+1:], text[:i]
+	}
+
+	var shortcut Shortcut
+	if modifierNames != "" {
+		for _, name := range strings.Split(modifierNames, "+") {
+			modifier, ok := modifierAliases[normalizeKeyName(name)]
+			if !ok {
+				return Shortcut{}, fmt.Errorf("unknown modifier %q in shortcut %q", strings.TrimSpace(name), text)
+			}
+			shortcut.Modifiers |= modifier
+		}
+	}
+
+	key, err := ParseKey(keyName)
+	if err != nil {
+		return Shortcut{}, fmt.Errorf("%w in shortcut %q", err, text)
+	}
+	shortcut.Key = key
+	return shortcut, nil
+}
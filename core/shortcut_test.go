@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import "testing"
+
+func TestKeyRoundTrip(t *testing.T) {
+	for key, name := range key2string {
+		got, err := ParseKey(name)
+		if err != nil {
+			t.Errorf("ParseKey(%q): %v", name, err)
+		} else if got.String() != name {
+			t.Errorf("ParseKey(%q) = %v (%#x), want %#x", name, got, uint16(got), uint16(key))
+		}
+	}
+}
+
+func TestShortcutRoundTrip(t *testing.T) {
+	keys := []Key{KeyA, Key0, KeyF12, KeyOEMPlus, KeyOEMMinus, KeyDelete, KeyAlt, KeyLWIN, KeyNumpad5}
+	for modifiers := range ModWin << 1 {
+		for _, key := range keys {
+			shortcut := Shortcut{Modifiers: modifiers, Key: key}
+			got, err := ParseShortcut(shortcut.String())
+			if err != nil {
+				t.Errorf("ParseShortcut(%q): %v", shortcut, err)
+			} else if got != shortcut {
+				t.Errorf("ParseShortcut(%q) = %q, want %q", shortcut, got, shortcut)
+			}
+		}
+	}
+}
+
+func TestParseShortcut(t *testing.T) {
+	tests := []struct {
+		text string
+		want Shortcut
+	}{
+		{"", Shortcut{}},
+		{"  ", Shortcut{}},
+		{"F5", Shortcut{Key: KeyF5}},
+		{"Ctrl+Alt+F5", Shortcut{ModControl | ModAlt, KeyF5}},
+		{"alt+ctrl+f5", Shortcut{ModControl | ModAlt, KeyF5}},
+		{"Control + Shift + S", Shortcut{ModControl | ModShift, KeyS}},
+		{"Win+E", Shortcut{ModWin, KeyE}},
+		{"Windows+Page Down", Shortcut{ModWin, KeyNext}},
+		{"Ctrl++", Shortcut{ModControl, KeyOEMPlus}},
+		{"+", Shortcut{Key: KeyOEMPlus}},
+		{"Ctrl+-", Shortcut{ModControl, KeyOEMMinus}},
+		{"Shift+Esc", Shortcut{ModShift, KeyEscape}},
+		{"Ctrl+Del", Shortcut{ModControl, KeyDelete}},
+		{"PgUp", Shortcut{Key: KeyPrior}},
+		{"Enter", Shortcut{Key: KeyReturn}},
+		{"Ctrl+Alt / Menu", Shortcut{ModControl, KeyAlt}},
+		{"Print Screen", Shortcut{Key: KeySnapshot}},
+	}
+	for _, test := range tests {
+		got, err := ParseShortcut(test.text)
+		if err != nil {
+			t.Errorf("ParseShortcut(%q): %v", test.text, err)
+		} else if got != test.want {
+			t.Errorf("ParseShortcut(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
+
+func TestParseShortcutErrors(t *testing.T) {
+	for _, text := range []string{"Ctrl+", "Hyper+A", "Ctrl+Foo", "Ctrl++A", "A+B"} {
+		if got, err := ParseShortcut(text); err == nil {
+			t.Errorf("ParseShortcut(%q) = %q, want an error", text, got)
+		}
+	}
+}
+
+func TestShortcutDisplayName(t *testing.T) {
+	defer func(saved func(Key) string) { LocalKeyName = saved }(LocalKeyName)
+
+	shortcut := Shortcut{ModControl | ModShift | ModWin, KeyDelete}
+	tests := []struct {
+		name  string
+		local func(Key) string
+		want  string
+	}{
+		{"no local names", nil, "Control+Shift+Win+Delete"},
+		{"local names", func(k Key) string {
+			return map[Key]string{KeyControl: "Strg", KeyShift: "Umschalt", KeyDelete: "Entf"}[k]
+		}, "Strg+Umschalt+Win+Entf"},
+		{"some local names", func(k Key) string {
+			return map[Key]string{KeyShift: "Maj"}[k]
+		}, "Control+Maj+Win+Delete"},
+	}
+	for _, test := range tests {
+		LocalKeyName = test.local
+		if got := shortcut.DisplayName(); got != test.want {
+			t.Errorf("%s: DisplayName = %q, want %q", test.name, got, test.want)
+		}
+	}
+	if got := (Shortcut{Key: KeyA}).DisplayName(); got != "A" {
+		t.Errorf("DisplayName without modifiers = %q, want A", got)
+	}
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+type Orientation int
+
+const (
+	Horizontal Orientation = iota
+	Vertical
+)
+
+type stackItem struct {
+	child   LayoutNode
+	stretch int
+	align   Alignment
+}
+
+// StackLayout places children in a single row or column.
+// Space left over is shared among children by their stretch weight,
+// within the limits set by SetMinSize and SetMaxSize.
+type StackLayout struct {
+	parent      LayoutContainer
+	orientation Orientation
+	items       []*stackItem
+	spacing     int
+
+	sizeToContent bool
+
+	layoutPadding
+}
+
+// NewStackLayout creates a stack that lays out nodes within parent.
+func NewStackLayout(parent LayoutContainer, orientation Orientation) *StackLayout {
+	return &StackLayout{parent: parent, orientation: orientation}
+}
+
+// Add child with a stretch weight. Children with weight 0 keep their size.
+func (stack *StackLayout) Add(child LayoutNode, stretch int) {
+	stack.items = append(stack.items, &stackItem{child, max(stretch, 0), AlignStretch})
+}
+
+// AddStretch adds empty space that grows with weight stretch.
+func (stack *StackLayout) AddStretch(stretch int) {
+	stack.Add(NewLayoutBox(0, 0), stretch)
+}
+
+// AddSpacing adds fixed empty space.
+func (stack *StackLayout) AddSpacing(size int) {
+	stack.Add(NewLayoutBox(size, size), 0)
+}
+
+func (stack *StackLayout) Remove(child LayoutNode) {
+	for i, item := range stack.items {
+		if item.child == child {
+			stack.items = append(stack.items[:i], stack.items[i+1:]...)
+			return
+		}
+	}
+}
+
+func (stack *StackLayout) find(child LayoutNode) *stackItem {
+	for _, item := range stack.items {
+		if item.child == child {
+			return item
+		}
+	}
+	return nil
+}
+
+func (stack *StackLayout) SetStretch(child LayoutNode, stretch int) {
+	if item := stack.find(child); item != nil {
+		item.stretch = max(stretch, 0)
+	}
+}
+
+// SetAlignment of child across the stack. Default is AlignStretch.
+func (stack *StackLayout) SetAlignment(child LayoutNode, alignment Alignment) {
+	if item := stack.find(child); item != nil {
+		item.align = alignment
+	}
+}
+
+func (stack *StackLayout) Orientation() Orientation {
+	return stack.orientation
+}
+
+func (stack *StackLayout) SetOrientation(orientation Orientation) {
+	stack.orientation = orientation
+}
+
+// SetSpacing sets space between neighbouring children.
+func (stack *StackLayout) SetSpacing(spacing int) {
+	stack.spacing = spacing
+}
+
+// SetSizeToContent makes the stack start from the preferred size of its children
+// and keep stretched children above their minimum size.
+func (stack *StackLayout) SetSizeToContent(enable bool) {
+	stack.sizeToContent = enable
+}
+
+// split returns main and cross components of width and height.
+func (stack *StackLayout) split(width, height int) (int, int) {
+	if stack.orientation == Vertical {
+		return height, width
+	}
+	return width, height
+}
+
+// clampMain limits size along the stack to the child constraints.
+func (stack *StackLayout) clampMain(child LayoutNode, size int) int {
+	minimum, _ := stack.split(nodeMinimumSize(child, stack.sizeToContent))
+	size = max(size, minimum)
+	if stack.orientation == Vertical {
+		_, size = clampNode(child, child.Width(), size)
+	} else {
+		size, _ = clampNode(child, size, child.Height())
+	}
+	return size
+}
+
+// Update is called to resize child items based on stretch weights.
+func (stack *StackLayout) Update() {
+	width := stack.parent.ClientWidth() - stack.padding_left - stack.padding_right
+	height := stack.parent.ClientHeight() - stack.padding_top - stack.padding_btm
+	main_space, cross_space := stack.split(width, height)
+	main_start, cross_start := stack.split(stack.padding_left, stack.padding_top)
+
+	var items []*stackItem
+	for _, item := range stack.items {
+		// Non visible controls do not reserve space.
+		if item.child.Visible() {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	remaining := main_space - stack.spacing*(len(items)-1)
+	sizes := make([]int, len(items))
+	fixed := make([]bool, len(items))
+	weights := 0
+	for i, item := range items {
+		margin_start, _ := stack.split(item.child.MarginLeft(), item.child.MarginTop())
+		margin_end, _ := stack.split(item.child.MarginRight(), item.child.MarginBtm())
+		remaining -= margin_start + margin_end
+
+		if item.stretch == 0 {
+			size, _ := stack.split(nodeSize(item.child, stack.sizeToContent))
+			sizes[i] = stack.clampMain(item.child, size)
+			fixed[i] = true
+			remaining -= sizes[i]
+		} else {
+			weights += item.stretch
+		}
+	}
+
+	// Share the rest by weight. Children that hit their limits keep them
+	// and the others share again what is left.
+	for weights > 0 {
+		space, weights_left := max(remaining, 0), weights
+		clamped := false
+		for i, item := range items {
+			if fixed[i] {
+				continue
+			}
+			share := space * item.stretch / weights_left
+			space -= share
+			weights_left -= item.stretch
+			sizes[i] = share
+		}
+		for i, item := range items {
+			if fixed[i] {
+				continue
+			}
+			if size := stack.clampMain(item.child, sizes[i]); size != sizes[i] {
+				sizes[i] = size
+				fixed[i] = true
+				remaining -= size
+				weights -= item.stretch
+				clamped = true
+			}
+		}
+		if !clamped {
+			break
+		}
+	}
+
+	main_pos := main_start
+	for i, item := range items {
+		child := item.child
+		margin_start, cross_margin_start := stack.split(child.MarginLeft(), child.MarginTop())
+		margin_end, cross_margin_end := stack.split(child.MarginRight(), child.MarginBtm())
+
+		main_pos += margin_start
+		_, child_cross := stack.split(nodeSize(child, stack.sizeToContent))
+		item_cross_space := cross_space - cross_margin_start - cross_margin_end
+		offset, cross := item.align.align(min(child_cross, item_cross_space), item_cross_space)
+
+		var child_width, child_height int
+		if stack.orientation == Vertical {
+			child_width, child_height = clampNode(child, cross, sizes[i])
+			if item.align != AlignStretch {
+				offset, _ = item.align.align(child_width, item_cross_space)
+			}
+			child.SetPos(cross_start+cross_margin_start+offset, main_pos)
+		} else {
+			child_width, child_height = clampNode(child, sizes[i], cross)
+			if item.align != AlignStretch {
+				offset, _ = item.align.align(child_height, item_cross_space)
+			}
+			child.SetPos(main_pos, cross_start+cross_margin_start+offset)
+		}
+		child.SetSize(child_width, child_height)
+
+		main_pos += sizes[i] + margin_end + stack.spacing
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import "testing"
+
+func TestStackLayout(t *testing.T) {
+	// A fixed 20x20 child, then children with stretch 1 and 3.
+	tests := []struct {
+		name        string
+		orientation Orientation
+		width       int
+		height      int
+		spacing     int
+		minOne      int // min size of the second child along the stack, 0 for none
+		maxLast     int // max size of the last child along the stack, 0 for none
+		want        [3]rect
+	}{
+		{"horizontal", Horizontal, 100, 20, 0, 0, 0, [3]rect{{0, 0, 20, 20}, {20, 0, 20, 20}, {40, 0, 60, 20}}},
+		{"horizontal with spacing", Horizontal, 100, 20, 10, 0, 0, [3]rect{{0, 0, 20, 20}, {30, 0, 15, 20}, {55, 0, 45, 20}}},
+		{"clamped share goes to the others", Horizontal, 100, 20, 0, 0, 30, [3]rect{{0, 0, 20, 20}, {20, 0, 50, 20}, {70, 0, 30, 20}}},
+		{"minimum takes from the others", Horizontal, 100, 20, 0, 40, 0, [3]rect{{0, 0, 20, 20}, {20, 0, 40, 20}, {60, 0, 40, 20}}},
+		{"minimum and maximum", Horizontal, 100, 20, 0, 40, 30, [3]rect{{0, 0, 20, 20}, {20, 0, 40, 20}, {60, 0, 30, 20}}},
+		{"vertical with spacing", Vertical, 20, 100, 5, 0, 0, [3]rect{{0, 0, 20, 20}, {0, 25, 20, 17}, {0, 47, 20, 53}}},
+		{"vertical minimum", Vertical, 20, 100, 5, 40, 0, [3]rect{{0, 0, 20, 20}, {0, 25, 20, 40}, {0, 70, 20, 30}}},
+		{"vertical maximum", Vertical, 20, 100, 5, 0, 30, [3]rect{{0, 0, 20, 20}, {0, 25, 20, 40}, {0, 70, 20, 30}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stack := NewStackLayout(NewLayoutArea(test.width, test.height), test.orientation)
+			stack.SetSpacing(test.spacing)
+			fixed, one, three := &fakeNode{width: 20, height: 20}, NewLayoutBox(0, 0), NewLayoutBox(0, 0)
+			if test.orientation == Vertical {
+				one.SetMinSize(0, test.minOne)
+				three.SetMaxSize(0, test.maxLast)
+			} else {
+				one.SetMinSize(test.minOne, 0)
+				three.SetMaxSize(test.maxLast, 0)
+			}
+			stack.Add(fixed, 0)
+			stack.Add(one, 1)
+			stack.Add(three, 3)
+			stack.Update()
+
+			for i, node := range []LayoutNode{fixed, one, three} {
+				if got := geometry(node); got != test.want[i] {
+					t.Errorf("child %d = %v, want %v", i, got, test.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestStackLayoutSpacer(t *testing.T) {
+	// Fixed spacing after the first child, stretch pushes the last one to the end.
+	stack := NewStackLayout(NewLayoutArea(100, 20), Horizontal)
+	first, second, last := &fakeNode{width: 20, height: 20}, &fakeNode{width: 10, height: 20}, &fakeNode{width: 20, height: 20}
+	stack.Add(first, 0)
+	stack.AddSpacing(10)
+	stack.Add(second, 0)
+	stack.AddStretch(1)
+	stack.Add(last, 0)
+	stack.Update()
+
+	if got, want := geometry(first), (rect{0, 0, 20, 20}); got != want {
+		t.Errorf("first = %v, want %v", got, want)
+	}
+	if got, want := geometry(second), (rect{30, 0, 10, 20}); got != want {
+		t.Errorf("second = %v, want %v", got, want)
+	}
+	if got, want := geometry(last), (rect{80, 0, 20, 20}); got != want {
+		t.Errorf("last = %v, want %v", got, want)
+	}
+}
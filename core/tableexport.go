@@ -0,0 +1,114 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// csvLineBreaks turns every line break into "\n", which csv.Writer writes as CRLF.
+// It would drop a lone CR otherwise.
+var csvLineBreaks = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+
+// WriteCSV writes header, unless nil, and rows as RFC 4180 CSV with CRLF line endings.
+// Rows shorter than the header or the longest row are padded with empty fields,
+// so every record has the same number of fields.
+func WriteCSV(w io.Writer, header []string, rows [][]string) error {
+	width := len(header)
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	writer := csv.NewWriter(w)
+	writer.UseCRLF = true
+	record := make([]string, width)
+	write := func(row []string) error {
+		clear(record)
+		for i, cell := range row {
+			record[i] = csvLineBreaks.Replace(cell)
+		}
+		return writer.Write(record)
+	}
+	if header != nil {
+		if err := write(header); err != nil {
+			return err
+		}
+	}
+	for _, row := range rows {
+		if err := write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// tsvReplacer blanks the characters that would break a TSV cell.
+var tsvReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\r", " ", "\n", " ")
+
+// WriteTSV writes header, unless nil, and rows as tab separated values with CRLF line endings,
+// the text spreadsheets expect on the clipboard. Tabs and line breaks in cells become spaces.
+func WriteTSV(w io.Writer, header []string, rows [][]string) error {
+	writer := bufio.NewWriter(w)
+	writeRow := func(row []string) {
+		for i, cell := range row {
+			if i > 0 {
+				writer.WriteByte('\t')
+			}
+			writer.WriteString(tsvReplacer.Replace(cell))
+		}
+		writer.WriteString("\r\n")
+	}
+	if header != nil {
+		writeRow(header)
+	}
+	for _, row := range rows {
+		writeRow(row)
+	}
+	return writer.Flush()
+}
+
+// WriteHTMLTable writes header, unless nil, and rows as an HTML table.
+func WriteHTMLTable(w io.Writer, header []string, rows [][]string) error {
+	writer := bufio.NewWriter(w)
+	writeRow := func(tag string, row []string) {
+		writer.WriteString("<tr>")
+		for _, cell := range row {
+			fmt.Fprintf(writer, "<%s>%s</%s>", tag, html.EscapeString(cell), tag)
+		}
+		writer.WriteString("</tr>\r\n")
+	}
+	writer.WriteString("<table>\r\n")
+	if header != nil {
+		writer.WriteString("<thead>\r\n")
+		writeRow("th", header)
+		writer.WriteString("</thead>\r\n")
+	}
+	writer.WriteString("<tbody>\r\n")
+	for _, row := range rows {
+		writeRow("td", row)
+	}
+	writer.WriteString("</tbody>\r\n</table>\r\n")
+	return writer.Flush()
+}
+
+// HTMLClipboardData wraps an HTML fragment in the header of the
+// "HTML Format" clipboard format, which gives the byte offsets of the document and fragment.
+func HTMLClipboardData(fragment string) []byte {
+	const header = "Version:0.9\r\nStartHTML:%010d\r\nEndHTML:%010d\r\nStartFragment:%010d\r\nEndFragment:%010d\r\n"
+	const prefix = "<html><body>\r\n<!--StartFragment-->"
+	const suffix = "<!--EndFragment-->\r\n</body></html>"
+
+	startHTML := len(fmt.Sprintf(header, 0, 0, 0, 0))
+	startFragment := startHTML + len(prefix)
+	endFragment := startFragment + len(fragment)
+	endHTML := endFragment + len(suffix)
+	return []byte(fmt.Sprintf(header, startHTML, endHTML, startFragment, endFragment) + prefix + fragment + suffix)
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestTableWriters(t *testing.T) {
+	header := []string{"Name", "Note"}
+	rows := [][]string{
+		{"plain", "a, \"quoted\" <b>&</b>"},
+		{"tab\there", "two\r\nlines\nand\rmore"},
+		{"short"},
+	}
+	// CSV writes every line break in cells as CRLF and pads rows to the same width.
+	tests := []struct {
+		name   string
+		write  func(io.Writer, []string, [][]string) error
+		header []string
+		rows   [][]string
+		want   string
+	}{
+		{"csv", WriteCSV, header, rows, "Name,Note\r\n" +
+			"plain,\"a, \"\"quoted\"\" <b>&</b>\"\r\n" +
+			"tab\there,\"two\r\nlines\r\nand\r\nmore\"\r\n" +
+			"short,\r\n"},
+		{"csv without header", WriteCSV, nil, rows, "plain,\"a, \"\"quoted\"\" <b>&</b>\"\r\n" +
+			"tab\there,\"two\r\nlines\r\nand\r\nmore\"\r\n" +
+			"short,\r\n"},
+		{"csv row wider than the header", WriteCSV, []string{"A"}, [][]string{{"1", "2", "3"}, {}}, "A,,\r\n" +
+			"1,2,3\r\n" +
+			",,\r\n"},
+		{"tsv", WriteTSV, header, rows, "Name\tNote\r\n" +
+			"plain\ta, \"quoted\" <b>&</b>\r\n" +
+			"tab here\ttwo lines and more\r\n" +
+			"short\r\n"},
+		{"tsv without header", WriteTSV, nil, rows, "plain\ta, \"quoted\" <b>&</b>\r\n" +
+			"tab here\ttwo lines and more\r\n" +
+			"short\r\n"},
+		{"html", WriteHTMLTable, header, rows, "<table>\r\n" +
+			"<thead>\r\n<tr><th>Name</th><th>Note</th></tr>\r\n</thead>\r\n" +
+			"<tbody>\r\n" +
+			"<tr><td>plain</td><td>a, &#34;quoted&#34; &lt;b&gt;&amp;&lt;/b&gt;</td></tr>\r\n" +
+			"<tr><td>tab\there</td><td>two\r\nlines\nand\rmore</td></tr>\r\n" +
+			"<tr><td>short</td></tr>\r\n" +
+			"</tbody>\r\n</table>\r\n"},
+		{"html without rows", WriteHTMLTable, nil, nil, "<table>\r\n<tbody>\r\n</tbody>\r\n</table>\r\n"},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := test.write(&buf, test.header, test.rows); err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("%s:\ngot  %q\nwant %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestHTMLClipboardData(t *testing.T) {
+	for _, fragment := range []string{"", "<table><tr><td>x</td></tr></table>", "déjà vu"} {
+		data := string(HTMLClipboardData(fragment))
+		var startHTML, endHTML, startFragment, endFragment int
+		if _, err := fmt.Sscanf(data, "Version:0.9\r\nStartHTML:%d\r\nEndHTML:%d\r\nStartFragment:%d\r\nEndFragment:%d\r\n",
+			&startHTML, &endHTML, &startFragment, &endFragment); err != nil {
+			t.Errorf("header of %q: %v", fragment, err)
+			continue
+		}
+		if got := data[startFragment:endFragment]; got != fragment {
+			t.Errorf("fragment at the offsets = %q, want %q", got, fragment)
+		}
+		if endHTML != len(data) || !strings.HasPrefix(data[startHTML:], "<html>") {
+			t.Errorf("document offsets %d-%d do not span the html of %q", startHTML, endHTML, data)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+// TreeItem represents an item in a TreeView widget.
+type TreeItem interface {
+	Text() string    // Text returns the text of the item.
+	ImageIndex() int // ImageIndex is used only if SetImageList is called on the treeview
+}
+
+// CheckState of a tree item.
+type CheckState int
+
+const (
+	Unchecked CheckState = iota
+	Checked
+	Indeterminate // some descendants are checked
+)
+
+// TreeChecks holds the check states of a tree and keeps them consistent:
+// checking an item checks all its descendants, and an ancestor is checked,
+// unchecked or indeterminate depending on its children.
+type TreeChecks struct {
+	states   map[TreeItem]CheckState
+	children func(item TreeItem) []TreeItem
+	parent   func(item TreeItem) TreeItem
+}
+
+// NewTreeChecks walks the tree with children and parent; parent returns nil for root items.
+func NewTreeChecks(children func(item TreeItem) []TreeItem, parent func(item TreeItem) TreeItem) *TreeChecks {
+	return &TreeChecks{states: map[TreeItem]CheckState{}, children: children, parent: parent}
+}
+
+func (checks *TreeChecks) State(item TreeItem) CheckState {
+	return checks.states[item]
+}
+
+// Set checks or unchecks item and its descendants, updates its ancestors
+// and returns the items whose state changed.
+func (checks *TreeChecks) Set(item TreeItem, checked bool) []TreeItem {
+	state := Unchecked
+	if checked {
+		state = Checked
+	}
+	var changed []TreeItem
+	checks.setTree(item, state, &changed)
+	return checks.updateAncestors(checks.parent(item), changed)
+}
+
+func (checks *TreeChecks) setTree(item TreeItem, state CheckState, changed *[]TreeItem) {
+	if checks.states[item] != state {
+		checks.set(item, state)
+		*changed = append(*changed, item)
+	}
+	for _, child := range checks.children(item) {
+		checks.setTree(child, state, changed)
+	}
+}
+
+func (checks *TreeChecks) set(item TreeItem, state CheckState) {
+	if state == Unchecked {
+		delete(checks.states, item)
+	} else {
+		checks.states[item] = state
+	}
+}
+
+// updateAncestors recomputes item and its ancestors from their children,
+// appending the items that changed to changed.
+func (checks *TreeChecks) updateAncestors(item TreeItem, changed []TreeItem) []TreeItem {
+	for ; item != nil; item = checks.parent(item) {
+		state := checks.fromChildren(item)
+		if state == checks.states[item] {
+			break
+		}
+		checks.set(item, state)
+		changed = append(changed, item)
+	}
+	return changed
+}
+
+func (checks *TreeChecks) fromChildren(item TreeItem) CheckState {
+	children := checks.children(item)
+	if len(children) == 0 {
+		return checks.states[item]
+	}
+	count := map[CheckState]int{}
+	for _, child := range children {
+		count[checks.states[child]]++
+	}
+	switch {
+	case count[Checked] == len(children):
+		return Checked
+	case count[Unchecked] == len(children):
+		return Unchecked
+	}
+	return Indeterminate
+}
+
+// Added gives a new item the state of a checked or unchecked parent
+// and returns the items whose state changed.
+func (checks *TreeChecks) Added(item TreeItem) []TreeItem {
+	parent := checks.parent(item)
+	var changed []TreeItem
+	if state := checks.states[parent]; parent != nil && state != Indeterminate {
+		checks.setTree(item, state, &changed)
+		return changed
+	}
+	return checks.updateAncestors(parent, changed)
+}
+
+// Forget drops the states of item and its descendants, before item is removed.
+func (checks *TreeChecks) Forget(item TreeItem) {
+	delete(checks.states, item)
+	for _, child := range checks.children(item) {
+		checks.Forget(child)
+	}
+}
+
+// Update recomputes item and its ancestors, after children of item were removed,
+// and returns the items whose state changed.
+func (checks *TreeChecks) Update(item TreeItem) []TreeItem {
+	return checks.updateAncestors(item, nil)
+}
+
+// Checked returns the checked items under root, depth first, or under the root items if root is nil.
+func (checks *TreeChecks) Checked(root TreeItem) []TreeItem {
+	var items []TreeItem
+	var walk func(item TreeItem)
+	walk = func(item TreeItem) {
+		for _, child := range checks.children(item) {
+			if checks.states[child] == Checked {
+				items = append(items, child)
+			}
+			if checks.states[child] != Unchecked {
+				walk(child)
+			}
+		}
+	}
+	walk(root)
+	return items
+}
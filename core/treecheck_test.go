@@ -0,0 +1,204 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+type fakeTreeItem struct {
+	name     string
+	parent   *fakeTreeItem
+	children []TreeItem
+}
+
+func (item *fakeTreeItem) Text() string    { return item.name }
+func (item *fakeTreeItem) ImageIndex() int { return -1 }
+
+// fakeTree is
+//
+//	a
+//	  a1
+//	  a2
+//	    a2x
+//	    a2y
+//	b
+type fakeTree struct {
+	roots []TreeItem
+	items map[string]*fakeTreeItem
+}
+
+func newFakeTree() *fakeTree {
+	tree := &fakeTree{items: map[string]*fakeTreeItem{}}
+	tree.add("a", "")
+	tree.add("a1", "a")
+	tree.add("a2", "a")
+	tree.add("a2x", "a2")
+	tree.add("a2y", "a2")
+	tree.add("b", "")
+	return tree
+}
+
+func (tree *fakeTree) add(name, parent string) *fakeTreeItem {
+	item := &fakeTreeItem{name: name, parent: tree.items[parent]}
+	if item.parent == nil {
+		tree.roots = append(tree.roots, item)
+	} else {
+		item.parent.children = append(item.parent.children, item)
+	}
+	tree.items[name] = item
+	return item
+}
+
+func (tree *fakeTree) remove(name string) {
+	item := tree.items[name]
+	item.parent.children = slices.DeleteFunc(item.parent.children, func(child TreeItem) bool { return child == item })
+	delete(tree.items, name)
+}
+
+func (tree *fakeTree) children(item TreeItem) []TreeItem {
+	if item == nil {
+		return tree.roots
+	}
+	return item.(*fakeTreeItem).children
+}
+
+func (tree *fakeTree) parent(item TreeItem) TreeItem {
+	if parent := item.(*fakeTreeItem).parent; parent != nil {
+		return parent
+	}
+	return nil
+}
+
+// states writes the state of every item as name=x for checked, name=~ for indeterminate.
+func (tree *fakeTree) states(checks *TreeChecks) string {
+	var states []string
+	for _, name := range []string{"a", "a1", "a2", "a2x", "a2y", "b", "a2z"} {
+		item, ok := tree.items[name]
+		if !ok {
+			continue
+		}
+		switch checks.State(item) {
+		case Checked:
+			states = append(states, name+"=x")
+		case Indeterminate:
+			states = append(states, name+"=~")
+		}
+	}
+	return strings.Join(states, " ")
+}
+
+func names(items []TreeItem) string {
+	var names []string
+	for _, item := range items {
+		names = append(names, item.Text())
+	}
+	return strings.Join(names, " ")
+}
+
+func TestTreeChecksSet(t *testing.T) {
+	type set struct {
+		name    string
+		checked bool
+	}
+	tests := []struct {
+		name    string
+		sets    []set
+		changed string // by the last set
+		states  string
+	}{
+		{"check a leaf", []set{{"a1", true}}, "a1 a", "a=~ a1=x"},
+		{"check a subtree", []set{{"a2", true}}, "a2 a2x a2y a", "a=~ a2=x a2x=x a2y=x"},
+		{"check all children", []set{{"a1", true}, {"a2", true}}, "a2 a2x a2y a", "a=x a1=x a2=x a2x=x a2y=x"},
+		{"check a grandchild", []set{{"a2y", true}}, "a2y a2 a", "a=~ a2=~ a2y=x"},
+		{"check the root", []set{{"a", true}}, "a a1 a2 a2x a2y", "a=x a1=x a2=x a2x=x a2y=x"},
+		{"uncheck a grandchild", []set{{"a", true}, {"a2x", false}}, "a2x a2 a", "a=~ a1=x a2=~ a2y=x"},
+		{"uncheck the last", []set{{"a2x", true}, {"a2x", false}}, "a2x a2 a", ""},
+		{"uncheck a root", []set{{"a2x", true}, {"a", false}}, "a a2 a2x", ""},
+		{"check a root leaf", []set{{"b", true}}, "b", "b=x"},
+		{"no change", []set{{"a1", true}, {"a1", true}}, "", "a=~ a1=x"},
+		{"indeterminate ancestor stays", []set{{"a1", true}, {"a2x", true}}, "a2x a2", "a=~ a1=x a2=~ a2x=x"},
+	}
+	for _, test := range tests {
+		tree := newFakeTree()
+		checks := NewTreeChecks(tree.children, tree.parent)
+		var changed []TreeItem
+		for _, set := range test.sets {
+			changed = checks.Set(tree.items[set.name], set.checked)
+		}
+		if got := names(changed); got != test.changed {
+			t.Errorf("%s: changed %q, want %q", test.name, got, test.changed)
+		}
+		if got := tree.states(checks); got != test.states {
+			t.Errorf("%s: states %q, want %q", test.name, got, test.states)
+		}
+	}
+}
+
+func TestTreeChecksAddRemove(t *testing.T) {
+	tests := []struct {
+		name    string
+		checked []string
+		add     string // child of a2, or "" to remove a2y instead
+		changed string
+		states  string
+	}{
+		{"add under checked", []string{"a2"}, "a2", "a2z", "a=~ a2=x a2x=x a2y=x a2z=x"},
+		{"add under unchecked", nil, "a2", "", ""},
+		{"add under indeterminate", []string{"a2x"}, "a2", "", "a=~ a2=~ a2x=x"},
+		{"add under checked leaf", []string{"b"}, "b", "a2z", "b=x a2z=x"},
+		{"remove the unchecked child", []string{"a2x"}, "", "a2", "a=~ a2=x a2x=x"},
+		{"remove the checked child", []string{"a2y"}, "", "a2 a", ""},
+	}
+	for _, test := range tests {
+		tree := newFakeTree()
+		checks := NewTreeChecks(tree.children, tree.parent)
+		for _, name := range test.checked {
+			checks.Set(tree.items[name], true)
+		}
+		var changed []TreeItem
+		if test.add != "" {
+			changed = checks.Added(tree.add("a2z", test.add))
+		} else {
+			checks.Forget(tree.items["a2y"])
+			tree.remove("a2y")
+			changed = checks.Update(tree.items["a2"])
+		}
+		if got := names(changed); got != test.changed {
+			t.Errorf("%s: changed %q, want %q", test.name, got, test.changed)
+		}
+		if got := tree.states(checks); got != test.states {
+			t.Errorf("%s: states %q, want %q", test.name, got, test.states)
+		}
+	}
+}
+
+func TestTreeChecksChecked(t *testing.T) {
+	tree := newFakeTree()
+	checks := NewTreeChecks(tree.children, tree.parent)
+	checks.Set(tree.items["a2"], true)
+	checks.Set(tree.items["b"], true)
+
+	tests := []struct {
+		root string
+		want string
+	}{
+		{"", "a2 a2x a2y b"},
+		{"a", "a2 a2x a2y"},
+		{"a2", "a2x a2y"},
+		{"a1", ""},
+	}
+	for _, test := range tests {
+		var root TreeItem
+		if test.root != "" {
+			root = tree.items[test.root]
+		}
+		if got := names(checks.Checked(root)); got != test.want {
+			t.Errorf("Checked(%q) = %q, want %q", test.root, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// UIElement describes a control and its children in a declarative UI document.
+//
+// In JSON the control type is the "type" key and children are listed under "children".
+// In XML the control type is the element name, properties are attributes and
+// children are nested elements:
+//
+//	<Form name="main" text="Hello" width="400" height="300">
+//		<LabeledEdit name="user" label="User" dock="Top" height="24" margins="4"/>
+//		<PushButton name="ok" text="OK" dock="BottomRight" fg="#202080">
+//			<Font family="Segoe UI" size="10" style="bold"/>
+//		</PushButton>
+//	</Form>
+type UIElement struct {
+	XMLName xml.Name `json:"-"`
+
+	Type       string   `json:"type" xml:"-"`
+	Name       string   `json:"name,omitempty" xml:"name,attr,omitempty"`
+	Text       string   `json:"text,omitempty" xml:"text,attr,omitempty"`
+	Label      string   `json:"label,omitempty" xml:"label,attr,omitempty"`
+	Dock       string   `json:"dock,omitempty" xml:"dock,attr,omitempty"`
+	Width      int      `json:"width,omitempty" xml:"width,attr,omitempty"`
+	Height     int      `json:"height,omitempty" xml:"height,attr,omitempty"`
+	LabelWidth int      `json:"labelWidth,omitempty" xml:"labelWidth,attr,omitempty"`
+	Margins    string   `json:"margins,omitempty" xml:"margins,attr,omitempty"`   // "all", "h,v" or "left,top,right,btm"
+	Paddings   string   `json:"paddings,omitempty" xml:"paddings,attr,omitempty"` // as Margins, containers only
+	FG         string   `json:"fg,omitempty" xml:"fg,attr,omitempty"`             // "#rrggbb"
+	BG         string   `json:"bg,omitempty" xml:"bg,attr,omitempty"`
+	Font       *UIFont  `json:"font,omitempty" xml:"Font,omitempty"`
+	Items      []string `json:"items,omitempty" xml:"Item,omitempty"` // combo box items
+
+	Children []*UIElement `json:"children,omitempty" xml:",any"`
+}
+
+// UIFont describes a font. Style is a space separated list of
+// bold, italic, underline and strikeout.
+type UIFont struct {
+	Family string `json:"family" xml:"family,attr"`
+	Size   int    `json:"size" xml:"size,attr"`
+	Style  string `json:"style,omitempty" xml:"style,attr,omitempty"`
+}
+
+// uiKind tells what a control type in a UI document may contain.
+type uiKind int
+
+const (
+	uiLeaf uiKind = iota
+	uiContainer
+	uiItems
+	uiRoot
+)
+
+var uiTypes = map[string]uiKind{
+	"Form":                uiRoot,
+	"AutoPanel":           uiContainer,
+	"GroupAutoPanel":      uiContainer,
+	"Label":               uiLeaf,
+	"Edit":                uiLeaf,
+	"MultiEdit":           uiLeaf,
+	"PushButton":          uiLeaf,
+	"CheckBox":            uiLeaf,
+	"RadioButton":         uiLeaf,
+	"ComboBox":            uiItems,
+	"ListComboBox":        uiItems,
+	"LabeledEdit":         uiLeaf,
+	"LabeledComboBox":     uiItems,
+	"LabeledListComboBox": uiItems,
+	"LabeledLabel":        uiLeaf,
+	"LabeledCheckBox":     uiLeaf,
+}
+
+var directionNames = map[string]Direction{
+	"Top":          Top,
+	"TopLeft":      TopLeft,
+	"TopCenter":    TopCenter,
+	"TopRight":     TopRight,
+	"Bottom":       Bottom,
+	"BottomLeft":   BottomLeft,
+	"BottomCenter": BottomCenter,
+	"BottomRight":  BottomRight,
+	"Left":         Left,
+	"LeftTop":      LeftTop,
+	"LeftCenter":   LeftCenter,
+	"LeftBottom":   LeftBottom,
+	"Right":        Right,
+	"RightTop":     RightTop,
+	"RightCenter":  RightCenter,
+	"RightBottom":  RightBottom,
+	"Fill":         Fill,
+	"Center":       Center,
+}
+
+// ParseDirection parses the name of a Direction, such as "TopLeft". Empty is Top.
+func ParseDirection(name string) (Direction, error) {
+	if name == "" {
+		return Top, nil
+	}
+	if dir, ok := directionNames[name]; ok {
+		return dir, nil
+	}
+	return Top, fmt.Errorf("unknown direction %q", name)
+}
+
+// ParseColor parses a color written as "#rrggbb".
+func ParseColor(text string) (Color, error) {
+	hex, ok := strings.CutPrefix(text, "#")
+	if !ok || len(hex) != 6 {
+		return 0, fmt.Errorf("invalid color %q, want #rrggbb", text)
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid color %q, want #rrggbb", text)
+	}
+	return RGB(byte(value>>16), byte(value>>8), byte(value)), nil
+}
+
+// ParseEdges parses margins or paddings as left, top, right, btm.
+func ParseEdges(text string) (left, top, right, btm int, err error) {
+	var values []int
+	for field := range strings.SplitSeq(text, ",") {
+		value, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return 0, 0, 0, 0, fmt.Errorf("invalid edges %q", text)
+		}
+		values = append(values, value)
+	}
+	switch len(values) {
+	case 1:
+		return values[0], values[0], values[0], values[0], nil
+	case 2:
+		return values[0], values[1], values[0], values[1], nil
+	case 4:
+		return values[0], values[1], values[2], values[3], nil
+	}
+	return 0, 0, 0, 0, fmt.Errorf("invalid edges %q, want 1, 2 or 4 values", text)
+}
+
+// ParseFontStyle parses a UIFont style into the Font style bits.
+func ParseFontStyle(text string) (byte, error) {
+	var style byte
+	for _, word := range strings.Fields(text) {
+		switch strings.ToLower(word) {
+		case "bold":
+			style |= FontBold
+		case "italic":
+			style |= FontItalic
+		case "underline":
+			style |= FontUnderline
+		case "strikeout":
+			style |= FontStrikeOut
+		default:
+			return 0, fmt.Errorf("unknown font style %q", word)
+		}
+	}
+	return style, nil
+}
+
+// ParseUIJSON reads and validates a JSON UI document.
+func ParseUIJSON(r io.Reader) (*UIElement, error) {
+	var root UIElement
+	if err := json.NewDecoder(r).Decode(&root); err != nil {
+		return nil, err
+	}
+	return &root, root.Validate()
+}
+
+// ParseUIXML reads and validates an XML UI document.
+func ParseUIXML(r io.Reader) (*UIElement, error) {
+	var root UIElement
+	if err := xml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, err
+	}
+	root.typeFromXML()
+	return &root, root.Validate()
+}
+
+func (element *UIElement) typeFromXML() {
+	element.Type = element.XMLName.Local
+	for _, child := range element.Children {
+		child.typeFromXML()
+	}
+}
+
+// Validate checks the whole tree and reports every problem found.
+// The root must be a Form and names must be unique.
+func (element *UIElement) Validate() error {
+	var errs []error
+	if element.Type != "Form" {
+		errs = append(errs, fmt.Errorf("windigo: ui root is %q, want Form", element.Type))
+	}
+	names := map[string]bool{}
+	element.validate(element.Type, names, &errs)
+	return errors.Join(errs...)
+}
+
+func (element *UIElement) validate(path string, names map[string]bool, errs *[]error) {
+	fail := func(format string, args ...any) {
+		*errs = append(*errs, fmt.Errorf("windigo: ui %s: %s", path, fmt.Sprintf(format, args...)))
+	}
+
+	kind, known := uiTypes[element.Type]
+	if !known {
+		fail("unknown type %q", element.Type)
+	}
+	if kind == uiRoot && path != element.Type {
+		fail("%s can only be the root", element.Type)
+	}
+	if element.Name != "" {
+		if names[element.Name] {
+			fail("duplicate name %q", element.Name)
+		}
+		names[element.Name] = true
+	}
+	if _, err := ParseDirection(element.Dock); err != nil {
+		fail("%v", err)
+	}
+	if element.Width < 0 || element.Height < 0 || element.LabelWidth < 0 {
+		fail("negative size")
+	}
+	if element.Margins != "" {
+		if _, _, _, _, err := ParseEdges(element.Margins); err != nil {
+			fail("margins: %v", err)
+		}
+	}
+	if element.Paddings != "" {
+		if kind != uiContainer && kind != uiRoot {
+			fail("paddings on %s", element.Type)
+		} else if _, _, _, _, err := ParseEdges(element.Paddings); err != nil {
+			fail("paddings: %v", err)
+		}
+	}
+	for _, color := range []string{element.FG, element.BG} {
+		if color != "" {
+			if _, err := ParseColor(color); err != nil {
+				fail("%v", err)
+			}
+		}
+	}
+	if element.Font != nil {
+		if element.Font.Size <= 0 {
+			fail("font size %d", element.Font.Size)
+		}
+		if _, err := ParseFontStyle(element.Font.Style); err != nil {
+			fail("%v", err)
+		}
+	}
+	if len(element.Items) > 0 && kind != uiItems && known {
+		fail("items on %s", element.Type)
+	}
+	if len(element.Children) > 0 && kind != uiContainer && kind != uiRoot && known {
+		fail("children on %s", element.Type)
+	}
+
+	for i, child := range element.Children {
+		child.validate(fmt.Sprintf("%s/%s[%d]", path, child.Type, i), names, errs)
+	}
+}
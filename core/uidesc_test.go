@@ -0,0 +1,181 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseUIJSON(t *testing.T) {
+	root, err := ParseUIJSON(strings.NewReader(`{
+		"type": "Form", "name": "main", "text": "Hello", "width": 400, "height": 300,
+		"children": [
+			{"type": "LabeledEdit", "name": "user", "label": "User", "dock": "Top", "margins": "4"},
+			{"type": "AutoPanel", "dock": "Fill", "paddings": "2,4", "children": [
+				{"type": "ComboBox", "name": "pick", "items": ["a", "b"]}
+			]}
+		]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.Type != "Form" || root.Name != "main" || root.Width != 400 || len(root.Children) != 2 {
+		t.Errorf("root = %+v", root)
+	}
+	panel := root.Children[1]
+	if panel.Type != "AutoPanel" || panel.Dock != "Fill" || len(panel.Children) != 1 {
+		t.Errorf("panel = %+v", panel)
+	}
+	if pick := panel.Children[0]; pick.Name != "pick" || strings.Join(pick.Items, ",") != "a,b" {
+		t.Errorf("combo box = %+v", pick)
+	}
+}
+
+func TestParseUIXML(t *testing.T) {
+	root, err := ParseUIXML(strings.NewReader(`
+		<Form name="main" text="Hello">
+			<LabeledComboBox name="kind" label="Kind" dock="Top">
+				<Item>one</Item>
+				<Item>two</Item>
+			</LabeledComboBox>
+			<PushButton name="ok" text="OK" dock="BottomRight" fg="#202080">
+				<Font family="Segoe UI" size="10" style="bold italic"/>
+			</PushButton>
+		</Form>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.Type != "Form" || len(root.Children) != 2 {
+		t.Fatalf("root = %+v", root)
+	}
+	if kind := root.Children[0]; kind.Type != "LabeledComboBox" || strings.Join(kind.Items, ",") != "one,two" {
+		t.Errorf("combo box = %+v", kind)
+	}
+	ok := root.Children[1]
+	if ok.Type != "PushButton" || ok.FG != "#202080" || ok.Font == nil || ok.Font.Size != 10 || ok.Font.Style != "bold italic" {
+		t.Errorf("button = %+v", ok)
+	}
+}
+
+func TestUIElementValidate(t *testing.T) {
+	form := func(children ...*UIElement) *UIElement {
+		return &UIElement{Type: "Form", Children: children}
+	}
+	tests := []struct {
+		name    string
+		element *UIElement
+		want    string // part of the error, "" for none
+	}{
+		{"valid", form(&UIElement{Type: "Edit", Name: "a", Dock: "Left", Margins: "1,2,3,4", FG: "#ffffff"}), ""},
+		{"root is not a form", &UIElement{Type: "AutoPanel"}, "want Form"},
+		{"form below the root", form(&UIElement{Type: "Form"}), "can only be the root"},
+		{"unknown type", form(&UIElement{Type: "Spinner"}), `unknown type "Spinner"`},
+		{"duplicate name", form(&UIElement{Type: "Edit", Name: "a"}, &UIElement{Type: "Label", Name: "a"}), `duplicate name "a"`},
+		{"unknown direction", form(&UIElement{Type: "Edit", Dock: "Up"}), `unknown direction "Up"`},
+		{"negative size", form(&UIElement{Type: "Edit", Width: -1}), "negative size"},
+		{"bad margins", form(&UIElement{Type: "Edit", Margins: "1,2,3"}), "margins"},
+		{"paddings on a leaf", form(&UIElement{Type: "Edit", Paddings: "1"}), "paddings on Edit"},
+		{"bad paddings", form(&UIElement{Type: "AutoPanel", Paddings: "x"}), "paddings"},
+		{"bad color", form(&UIElement{Type: "Edit", BG: "red"}), `invalid color "red"`},
+		{"font size", form(&UIElement{Type: "Edit", Font: &UIFont{Family: "Arial"}}), "font size 0"},
+		{"font style", form(&UIElement{Type: "Edit", Font: &UIFont{Family: "Arial", Size: 9, Style: "heavy"}}), `unknown font style "heavy"`},
+		{"items on a label", form(&UIElement{Type: "Label", Items: []string{"a"}}), "items on Label"},
+		{"children on an edit", form(&UIElement{Type: "Edit", Children: []*UIElement{{Type: "Label"}}}), "children on Edit"},
+		{"path of nested errors", form(&UIElement{Type: "AutoPanel", Children: []*UIElement{{Type: "Edit", Width: -1}}}), "Form/AutoPanel[0]/Edit[0]: negative size"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.element.Validate()
+			switch {
+			case test.want == "" && err != nil:
+				t.Errorf("Validate() = %v, want nil", err)
+			case test.want != "" && (err == nil || !strings.Contains(err.Error(), test.want)):
+				t.Errorf("Validate() = %v, want an error containing %q", err, test.want)
+			}
+		})
+	}
+}
+
+func TestUIElementValidateReportsAll(t *testing.T) {
+	err := (&UIElement{Type: "Form", Children: []*UIElement{{Type: "Edit", Width: -1}, {Type: "Spinner"}}}).Validate()
+	if err == nil || !strings.Contains(err.Error(), "negative size") || !strings.Contains(err.Error(), "Spinner") {
+		t.Errorf("Validate() = %v, want both errors", err)
+	}
+}
+
+func TestParseEdges(t *testing.T) {
+	tests := []struct {
+		text                  string
+		left, top, right, btm int
+		fails                 bool
+	}{
+		{"4", 4, 4, 4, 4, false},
+		{"2, 6", 2, 6, 2, 6, false},
+		{"1,2,3,4", 1, 2, 3, 4, false},
+		{"1,2,3", 0, 0, 0, 0, true},
+		{"a", 0, 0, 0, 0, true},
+		{"", 0, 0, 0, 0, true},
+	}
+	for _, test := range tests {
+		left, top, right, btm, err := ParseEdges(test.text)
+		if (err != nil) != test.fails || left != test.left || top != test.top || right != test.right || btm != test.btm {
+			t.Errorf("ParseEdges(%q) = %d, %d, %d, %d, %v", test.text, left, top, right, btm, err)
+		}
+	}
+}
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  Color
+		fails bool
+	}{
+		{"#000000", RGB(0, 0, 0), false},
+		{"#ff8001", RGB(255, 128, 1), false},
+		{"#FF8001", RGB(255, 128, 1), false},
+		{"ff8001", 0, true},
+		{"#ff80", 0, true},
+		{"#gg8001", 0, true},
+	}
+	for _, test := range tests {
+		got, err := ParseColor(test.text)
+		if (err != nil) != test.fails || got != test.want {
+			t.Errorf("ParseColor(%q) = %#x, %v, want %#x", test.text, got, err, test.want)
+		}
+	}
+}
+
+func TestParseFontStyle(t *testing.T) {
+	tests := []struct {
+		text  string
+		want  byte
+		fails bool
+	}{
+		{"", FontNormal, false},
+		{"bold", FontBold, false},
+		{"Italic underline StrikeOut", FontItalic | FontUnderline | FontStrikeOut, false},
+		{"bold heavy", 0, true},
+	}
+	for _, test := range tests {
+		got, err := ParseFontStyle(test.text)
+		if (err != nil) != test.fails || got != test.want {
+			t.Errorf("ParseFontStyle(%q) = %#x, %v, want %#x", test.text, got, err, test.want)
+		}
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	for name, want := range directionNames {
+		if got, err := ParseDirection(name); err != nil || got != want {
+			t.Errorf("ParseDirection(%q) = %v, %v, want %v", name, got, err, want)
+		}
+	}
+	if got, err := ParseDirection(""); err != nil || got != Top {
+		t.Errorf("ParseDirection(\"\") = %v, %v, want Top", got, err)
+	}
+	if _, err := ParseDirection("top"); err == nil {
+		t.Error("ParseDirection(\"top\") succeeded, names are case sensitive")
+	}
+}
@@ -0,0 +1,192 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Validator checks the text of a field and describes what is wrong with it.
+// Any func(string) error can be used as a custom validator.
+type Validator func(text string) error
+
+// Required rejects empty or blank text.
+func Required(message string) Validator {
+	return func(text string) error {
+		if strings.TrimSpace(text) == "" {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// MatchRegexp rejects text that does not match pattern. It panics if pattern does not compile.
+// Empty text is accepted, combine with Required if needed.
+func MatchRegexp(pattern, message string) Validator {
+	re := regexp.MustCompile(pattern)
+	return func(text string) error {
+		if text != "" && !re.MatchString(text) {
+			return errors.New(message)
+		}
+		return nil
+	}
+}
+
+// IntRange rejects text that is not an integer between min and max inclusive.
+// Empty text is accepted.
+func IntRange(min, max int) Validator {
+	return func(text string) error {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil
+		}
+		value, err := strconv.Atoi(text)
+		if err != nil {
+			return fmt.Errorf("%q is not a whole number", text)
+		}
+		if value < min || value > max {
+			return fmt.Errorf("must be between %d and %d", min, max)
+		}
+		return nil
+	}
+}
+
+// FloatRange rejects text that is not a number between min and max inclusive.
+// Empty text is accepted.
+func FloatRange(min, max float64) Validator {
+	return func(text string) error {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return nil
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", text)
+		}
+		if value < min || value > max {
+			return fmt.Errorf("must be between %g and %g", min, max)
+		}
+		return nil
+	}
+}
+
+// MaxLength rejects text longer than length characters.
+func MaxLength(length int) Validator {
+	return func(text string) error {
+		if utf8.RuneCountInString(text) > length {
+			return fmt.Errorf("must be at most %d characters", length)
+		}
+		return nil
+	}
+}
+
+// ValidationField is a field checked by a list of validators.
+type ValidationField struct {
+	name       string
+	text       func() string
+	validators []Validator
+	err        error
+	group      *ValidationGroup
+
+	// Validated, if set, is called after every Validate.
+	Validated func(*ValidationField)
+}
+
+func (field *ValidationField) Name() string {
+	return field.name
+}
+
+// Err is the result of the last Validate.
+func (field *ValidationField) Err() error {
+	return field.err
+}
+
+// Validate runs the validators in order and keeps the first error.
+func (field *ValidationField) Validate() error {
+	text := field.text()
+	field.err = nil
+	for _, validate := range field.validators {
+		if err := validate(text); err != nil {
+			field.err = err
+			break
+		}
+	}
+	if field.Validated != nil {
+		field.Validated(field)
+	}
+	if field.group != nil && field.group.Validated != nil {
+		field.group.Validated(field.group)
+	}
+	return field.err
+}
+
+// ValidationGroup holds the fields of a form.
+type ValidationGroup struct {
+	fields []*ValidationField
+
+	// Validated, if set, is called after any field was validated.
+	Validated func(*ValidationGroup)
+}
+
+func NewValidationGroup() *ValidationGroup {
+	return &ValidationGroup{}
+}
+
+// Add a field named name whose text is read by text.
+func (group *ValidationGroup) Add(name string, text func() string, validators ...Validator) *ValidationField {
+	field := &ValidationField{name: name, text: text, validators: validators, group: group}
+	group.fields = append(group.fields, field)
+	return field
+}
+
+func (group *ValidationGroup) Fields() []*ValidationField {
+	return group.fields
+}
+
+// Validate every field and report whether all are valid.
+func (group *ValidationGroup) Validate() bool {
+	valid := true
+	for _, field := range group.fields {
+		if field.Validate() != nil {
+			valid = false
+		}
+	}
+	return valid
+}
+
+// Valid reports whether all fields passed their last validation.
+func (group *ValidationGroup) Valid() bool {
+	return group.FirstInvalid() == nil
+}
+
+// FirstInvalid returns the first field that failed its last validation.
+func (group *ValidationGroup) FirstInvalid() *ValidationField {
+	for _, field := range group.fields {
+		if field.err != nil {
+			return field
+		}
+	}
+	return nil
+}
+
+// Messages lists the errors of the last validation as "name: error".
+func (group *ValidationGroup) Messages() []string {
+	var messages []string
+	for _, field := range group.fields {
+		if field.err != nil {
+			if field.name != "" {
+				messages = append(messages, field.name+": "+field.err.Error())
+			} else {
+				messages = append(messages, field.err.Error())
+			}
+		}
+	}
+	return messages
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package core
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator Validator
+		text      string
+		valid     bool
+	}{
+		{"required", Required("needed"), "x", true},
+		{"required empty", Required("needed"), "", false},
+		{"required blank", Required("needed"), " \t", false},
+		{"regexp", MatchRegexp(`^\d{3}$`, "three digits"), "123", true},
+		{"regexp mismatch", MatchRegexp(`^\d{3}$`, "three digits"), "12a", false},
+		{"regexp empty", MatchRegexp(`^\d{3}$`, "three digits"), "", true},
+		{"int range", IntRange(1, 10), " 10 ", true},
+		{"int range low", IntRange(1, 10), "0", false},
+		{"int range not a number", IntRange(1, 10), "1.5", false},
+		{"int range empty", IntRange(1, 10), "", true},
+		{"float range", FloatRange(0, 1), "0.5", true},
+		{"float range high", FloatRange(0, 1), "1.01", false},
+		{"float range not a number", FloatRange(0, 1), "half", false},
+		{"max length", MaxLength(3), "äöü", true},
+		{"max length over", MaxLength(3), "abcd", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.validator(test.text); (err == nil) != test.valid {
+				t.Errorf("validator(%q) = %v, want valid %v", test.text, err, test.valid)
+			}
+		})
+	}
+}
+
+func TestValidationGroup(t *testing.T) {
+	name, age := "", "200"
+	group := NewValidationGroup()
+	groupCalls := 0
+	group.Validated = func(*ValidationGroup) { groupCalls++ }
+	nameField := group.Add("Name", func() string { return name }, Required("is required"), MaxLength(3))
+	ageField := group.Add("Age", func() string { return age }, IntRange(0, 150))
+	var validated []string
+	nameField.Validated = func(field *ValidationField) { validated = append(validated, field.Name()) }
+
+	if !group.Valid() {
+		t.Error("Valid() before any validation, want true")
+	}
+	if group.Validate() {
+		t.Error("Validate() = true with two invalid fields")
+	}
+	if groupCalls != 2 || !slices.Equal(validated, []string{"Name"}) {
+		t.Errorf("group called %d times, fields validated %v", groupCalls, validated)
+	}
+	if group.FirstInvalid() != nameField {
+		t.Errorf("FirstInvalid() = %v, want the name field", group.FirstInvalid())
+	}
+	want := []string{"Name: is required", "Age: must be between 0 and 150"}
+	if got := group.Messages(); !slices.Equal(got, want) {
+		t.Errorf("Messages() = %q, want %q", got, want)
+	}
+
+	name = "Anne"
+	if err := nameField.Validate(); err == nil || err.Error() != "must be at most 3 characters" {
+		t.Errorf("Validate() = %v, want the error of the second validator", err)
+	}
+	name, age = "Ann", "40"
+	if !group.Validate() || !group.Valid() || group.FirstInvalid() != nil || group.Messages() != nil {
+		t.Errorf("all valid: Valid %v, FirstInvalid %v, Messages %q", group.Valid(), group.FirstInvalid(), group.Messages())
+	}
+	if ageField.Err() != nil {
+		t.Errorf("age Err() = %v", ageField.Err())
+	}
+}
+
+func TestValidationFieldUnnamed(t *testing.T) {
+	group := NewValidationGroup()
+	group.Add("", func() string { return "" }, func(string) error { return errors.New("custom") })
+	group.Validate()
+	if got := group.Messages(); !slices.Equal(got, []string{"custom"}) {
+		t.Errorf("Messages() = %q, want the bare error of an unnamed field", got)
+	}
+}
@@ -18,6 +18,9 @@ type Dialog struct {
 
 	btnOk     *PushButton
 	btnCancel *PushButton
+	validator *FormValidator
+	okSub     Subscription
+	okValid   bool // result of the validation run by the ok button
 
 	onLoad   EventManager
 	onOk     EventManager
@@ -34,7 +37,7 @@ func NewDialog(parent Controller) *Dialog {
 	control.hwnd = CreateWindow("windigo_Dialog", parent, w32.WS_EX_CONTROLPARENT, /* IMPORTANT */
 		w32.WS_SYSMENU|w32.WS_CAPTION|w32.WS_THICKFRAME /*|w32.WS_BORDER|w32.WS_POPUP*/)
 	control.parent = parent
-	control.local_shortcuts = make(map[Shortcut]func() bool)
+	control.commands = NewCommands()
 
 	// this might fail if icon resource is not embedded in the binary
 	if ico, err := NewIconFromResource(GetAppInstance(), uint16(AppIconID)); err == nil {
@@ -59,11 +62,28 @@ func (control *Dialog) SetModal(modal bool) {
 
 // SetButtons wires up dialog events to buttons. btnCancel can be nil.
 func (control *Dialog) SetButtons(btnOk *PushButton, btnCancel *PushButton) {
+	control.okSub.Unsubscribe()
 	control.btnOk = btnOk
 	control.btnOk.SetDefault()
+	// The ok button validates for IDOK as well, so validation runs once.
+	control.okSub = control.btnOk.onClick.subscribeFirst(func(arg *Event) {
+		if control.okValid = control.valid(); !control.okValid {
+			arg.SetHandled()
+		}
+	})
 	control.btnCancel = btnCancel
 }
 
+// SetValidator keeps the dialog from OnOk, and the ok button from OnClick,
+// until every field of validator is valid.
+func (control *Dialog) SetValidator(validator *FormValidator) {
+	control.validator = validator
+}
+
+func (control *Dialog) valid() bool {
+	return control.validator == nil || control.validator.ValidateAndFocus()
+}
+
 // Events
 func (control *Dialog) OnLoad() *EventManager {
 	return &control.onLoad
@@ -84,14 +104,9 @@ func (control *Dialog) PreTranslateMessage(msg *w32.MSG) bool {
 		// Shortcut support.
 		key := Key(msg.WParam)
 		if uint32(msg.LParam)>>30 == 0 {
-			shortcut := Shortcut{ModifiersDown(), key}
-			if action, ok := control.local_shortcuts[shortcut]; ok {
-				return action()
-			} else if action, ok := shortcut2Action[shortcut]; ok { // Access menu shortcuts. This may not be what we want. TODO check isModal?
-				if action.Enabled() {
-					action.onClick.Fire(NewEvent(control, msg.Pt))
-					return true
-				}
+			shortcut := Shortcut{Modifiers: ModifiersDown(), Key: key}
+			if control.pressKey(shortcut, msg.Pt) {
+				return true
 			}
 		}
 	}
@@ -116,6 +131,7 @@ func (control *Dialog) Close() {
 	if control.isModal {
 		control.Parent().SetEnabled(true)
 	}
+	control.UnregisterHotKeys()
 	control.ControlBase.Close()
 }
 
@@ -132,7 +148,13 @@ func (control *Dialog) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 		switch w32.LOWORD(uint32(wparam)) {
 		case w32.IDOK:
 			if control.btnOk != nil {
+				control.okValid = false
 				control.btnOk.onClick.Fire(NewEvent(control.btnOk, nil))
+				if !control.okValid {
+					return w32.TRUE
+				}
+			} else if !control.valid() {
+				return w32.TRUE
 			}
 			control.onOk.Fire(NewEvent(control, nil))
 			return w32.TRUE
@@ -141,11 +163,16 @@ func (control *Dialog) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 			control.cancel()
 			return w32.TRUE
 		}
+	case w32.WM_TIMER:
+		control.chordTimer(wparam)
+	case w32.WM_HOTKEY:
+		control.hotKey(int32(wparam))
 	case w32.WM_CLOSE:
 		control.cancel() // use onCancel or control.btnCancel.OnClick to close
 		return 0
 
 	case w32.WM_DESTROY:
+		control.UnregisterHotKeys()
 		if control.isModal {
 			control.Parent().SetEnabled(true)
 		}
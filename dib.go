@@ -0,0 +1,23 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"image"
+
+	"github.com/samuel-jimenez/windigo/core"
+)
+
+const dibHeaderSize = core.DIBHeaderSize
+
+// See core.DecodeDIB.
+func DecodeDIB(data []byte) (image.Image, error) {
+	return core.DecodeDIB(data)
+}
+
+// See core.EncodeDIB.
+func EncodeDIB(img image.Image) []byte {
+	return core.EncodeDIB(img)
+}
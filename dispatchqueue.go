@@ -0,0 +1,15 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// DispatchQueue collects functions posted from any goroutine
+// and runs them on the goroutine that calls Drain, see core.DispatchQueue.
+type DispatchQueue = core.DispatchQueue
+
+func NewDispatchQueue(wake func()) *DispatchQueue {
+	return core.NewDispatchQueue(wake)
+}
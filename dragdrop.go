@@ -0,0 +1,466 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// DragDrop describes items dragged from a TreeView or ListView
+// and where they would be dropped.
+type DragDrop struct {
+	Source    Controller // TreeView or ListView the items come from
+	TreeItems []TreeItem // items dragged from a TreeView
+	ListItems []ListItem // items dragged from a ListView
+
+	Target   Controller // TreeView or ListView under the cursor
+	TreeItem TreeItem   // TreeView item under the cursor, nil over empty space
+	ListItem ListItem   // ListView item under the cursor, nil over empty space
+	Index    int        // ListView row under the cursor, ItemCount over empty space
+}
+
+// DropHandler accepts items dropped on a TreeView or ListView, see SetDropHandler.
+type DropHandler interface {
+	// CanDrop is asked as the cursor moves over the target.
+	CanDrop(drag *DragDrop) bool
+	Drop(drag *DragDrop)
+}
+
+// DropConverter is a DropHandler that converts the items moved between a TreeView
+// and a ListView, as an item can not be both a TreeItem and a ListItem. See DragDrop.Move.
+type DropConverter interface {
+	ToListItem(item TreeItem) ListItem
+	ToTreeItem(item ListItem) TreeItem
+}
+
+// DropHandlerFuncs is a DropHandler made of funcs. A nil CanDropFunc accepts any drop.
+// ToListItemFunc and ToTreeItemFunc make it a DropConverter.
+type DropHandlerFuncs struct {
+	CanDropFunc    func(drag *DragDrop) bool
+	DropFunc       func(drag *DragDrop)
+	ToListItemFunc func(item TreeItem) ListItem
+	ToTreeItemFunc func(item ListItem) TreeItem
+}
+
+func (handler DropHandlerFuncs) CanDrop(drag *DragDrop) bool {
+	return handler.CanDropFunc == nil || handler.CanDropFunc(drag)
+}
+
+func (handler DropHandlerFuncs) Drop(drag *DragDrop) {
+	handler.DropFunc(drag)
+}
+
+func (handler DropHandlerFuncs) ToListItem(item TreeItem) ListItem {
+	if handler.ToListItemFunc == nil {
+		return nil
+	}
+	return handler.ToListItemFunc(item)
+}
+
+func (handler DropHandlerFuncs) ToTreeItem(item ListItem) TreeItem {
+	if handler.ToTreeItemFunc == nil {
+		return nil
+	}
+	return handler.ToTreeItemFunc(item)
+}
+
+// Items returns the dragged items, TreeItems or ListItems.
+func (drag *DragDrop) Items() []any {
+	var items []any
+	for _, item := range drag.TreeItems {
+		items = append(items, item)
+	}
+	for _, item := range drag.ListItems {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Move inserts the dragged items at the target, then removes them from the source:
+// before the row under the cursor of a ListView, or as the last children of the item
+// under the cursor of a TreeView. TreeView items move with their children; only
+// items without children can be moved to a ListView. Items moved between a TreeView
+// and a ListView are converted by the DropConverter of the target's DropHandler.
+// Move does not work on a TreeView that has a TreeModel or on a virtual ListView,
+// and a TreeView item can not be moved below itself.
+// Nothing is changed if an item could not be converted, and nothing is removed
+// from the source if an item could not be inserted.
+func (drag *DragDrop) Move() error {
+	nodes, rows, err := drag.checkMove()
+	if err != nil {
+		return err
+	}
+
+	// Inserting an item in its own control replaces the item's node or row,
+	// so the ones to delete are recorded first.
+	var handles []w32.HTREEITEM
+	var lparams []uintptr
+	switch source := drag.Source.(type) {
+	case *TreeView:
+		for _, item := range drag.TreeItems {
+			if !source.hasAncestorIn(item, drag.TreeItems) {
+				handles = append(handles, source.item2Info[item].handle)
+			}
+		}
+	case *ListView:
+		for _, item := range drag.ListItems {
+			lparams = append(lparams, source.item2Handle[item])
+		}
+	}
+
+	switch target := drag.Target.(type) {
+	case *TreeView:
+		for _, node := range nodes {
+			if err := target.insertSubtree(node, drag.TreeItem); err != nil {
+				return err
+			}
+		}
+		if drag.TreeItem != nil {
+			target.Expand(drag.TreeItem)
+		}
+	case *ListView:
+		for i, row := range rows {
+			if err := target.TryInsertItem(row, drag.Index+i); err != nil {
+				return err
+			}
+		}
+	}
+
+	switch source := drag.Source.(type) {
+	case *TreeView:
+		for _, handle := range handles {
+			if !source.deleteNode(handle) {
+				return errors.New("windigo: TVM_DELETEITEM failed")
+			}
+		}
+	case *ListView:
+		for _, lparam := range lparams {
+			if err := source.deleteRow(lparam); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// treeNode is a TreeView item with its children, read before Move changes the tree.
+type treeNode struct {
+	item     TreeItem
+	children []*treeNode
+}
+
+// subtree returns item with its descendants.
+func (tv *TreeView) subtree(item TreeItem) *treeNode {
+	node := &treeNode{item: item}
+	child := w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_CHILD, uintptr(tv.item2Info[item].handle))
+	for ; child != 0; child = w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_NEXT, child) {
+		if childItem, ok := tv.handle2Item[w32.HTREEITEM(child)]; ok {
+			node.children = append(node.children, tv.subtree(childItem))
+		}
+	}
+	return node
+}
+
+// insertSubtree inserts node and its descendants as the last child of parent.
+func (tv *TreeView) insertSubtree(node *treeNode, parent TreeItem) error {
+	if err := tv.InsertItem(node.item, parent, nil); err != nil {
+		return err
+	}
+	for _, child := range node.children {
+		if err := tv.insertSubtree(child, node.item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasAncestorIn reports whether an ancestor of item is one of items.
+// It moves along with that ancestor.
+func (tv *TreeView) hasAncestorIn(item TreeItem, items []TreeItem) bool {
+	for parent := tv.parentItem(item); parent != nil; parent = tv.parentItem(parent) {
+		if slices.Contains(items, parent) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMove returns why Move can not move the dragged items, before anything changes.
+// Otherwise it returns what to insert in a TreeView or ListView target.
+func (drag *DragDrop) checkMove() ([]*treeNode, []ListItem, error) {
+	for _, control := range []Controller{drag.Source, drag.Target} {
+		switch control := control.(type) {
+		case *TreeView:
+			if control.model != nil {
+				return nil, nil, errors.New("windigo: Move does not work on a TreeView with a TreeModel")
+			}
+		case *ListView:
+			if control.cache != nil {
+				return nil, nil, errors.New("windigo: Move does not work on a virtual ListView")
+			}
+		default:
+			return nil, nil, errors.New("windigo: Move needs a TreeView or ListView")
+		}
+	}
+
+	switch source := drag.Source.(type) {
+	case *TreeView:
+		for _, item := range drag.TreeItems {
+			if source.item2Info[item] == nil {
+				return nil, nil, errors.New("windigo: dragged item not found")
+			}
+		}
+	case *ListView:
+		for _, item := range drag.ListItems {
+			if _, ok := source.item2Handle[item]; !ok {
+				return nil, nil, errors.New("windigo: dragged item not found")
+			}
+		}
+	}
+
+	var converter DropConverter
+	if target, ok := drag.Target.(dropTarget); ok {
+		converter, _ = target.dropHandler().(DropConverter)
+	}
+
+	var nodes []*treeNode
+	var rows []ListItem
+	switch target := drag.Target.(type) {
+	case *TreeView:
+		// Deleting the source node would delete the target with it.
+		if drag.Target == drag.Source {
+			for parent := drag.TreeItem; parent != nil; parent = target.parentItem(parent) {
+				if slices.Contains(drag.TreeItems, parent) {
+					return nil, nil, errors.New("windigo: can not move a TreeView item below itself")
+				}
+			}
+		}
+		if source, ok := drag.Source.(*TreeView); ok {
+			for _, item := range drag.TreeItems {
+				if !source.hasAncestorIn(item, drag.TreeItems) {
+					nodes = append(nodes, source.subtree(item))
+				}
+			}
+		}
+		for _, item := range drag.ListItems {
+			var converted TreeItem
+			if converter != nil {
+				converted = converter.ToTreeItem(item)
+			}
+			if converted == nil {
+				return nil, nil, fmt.Errorf("windigo: %T is not converted to a TreeItem, see DropConverter", item)
+			}
+			nodes = append(nodes, &treeNode{item: converted})
+		}
+	case *ListView:
+		if source, ok := drag.Source.(*TreeView); ok {
+			for _, item := range drag.TreeItems {
+				if len(source.subtree(item).children) > 0 {
+					return nil, nil, errors.New("windigo: can not move a TreeView item with children to a ListView")
+				}
+			}
+		}
+		for _, item := range drag.TreeItems {
+			var converted ListItem
+			if converter != nil {
+				converted = converter.ToListItem(item)
+			}
+			if converted == nil {
+				return nil, nil, fmt.Errorf("windigo: %T is not converted to a ListItem, see DropConverter", item)
+			}
+			rows = append(rows, converted)
+		}
+		rows = append(rows, drag.ListItems...)
+	}
+	return nodes, rows, nil
+}
+
+// dropTarget is a control that items can be dropped on.
+type dropTarget interface {
+	Controller
+	dropHandler() DropHandler
+	// dropAt sets the target fields of drag for the client point x, y.
+	dropAt(drag *DragDrop, x, y int)
+	// highlightDrop marks the target of drag, or clears the mark.
+	highlightDrop(drag *DragDrop, show bool)
+}
+
+// dragState is the drag in progress. There is one at most, on the UI thread.
+type dragState struct {
+	drag    DragDrop
+	image   w32.HIMAGELIST
+	target  dropTarget
+	canDrop bool
+	timer   uintptr
+}
+
+var (
+	gDrag        *dragState
+	gDropTargets = make(map[w32.HWND]dropTarget)
+)
+
+const (
+	dragScrollMargin   = 16 // pixels from the edge of a target that scroll it
+	dragScrollInterval = 50 // milliseconds
+)
+
+// dragScrollDirection returns -1 near the top of a target height pixels high, 1 near the bottom, else 0.
+func dragScrollDirection(y, height int) int {
+	switch {
+	case y < dragScrollMargin:
+		return -1
+	case y >= height-dragScrollMargin:
+		return 1
+	}
+	return 0
+}
+
+func setDropHandler(target dropTarget, handler DropHandler) {
+	if handler == nil {
+		delete(gDropTargets, target.Handle())
+	} else {
+		gDropTargets[target.Handle()] = target
+	}
+}
+
+// beginDrag starts dragging with the drag image image, which it destroys when done.
+// x, y are the cursor position in the client area of the source.
+func beginDrag(drag DragDrop, image w32.HIMAGELIST, x, y int) {
+	if gDrag != nil {
+		gDrag.end(false)
+	}
+	source := drag.Source.Handle()
+	state := &dragState{drag: drag, image: image}
+	if image != 0 {
+		w32.ImageList_BeginDrag(image, 0, 0, 0)
+		screenX, screenY := w32.ClientToScreen(source, x, y)
+		w32.ImageList_DragEnter(0, screenX, screenY)
+	}
+	w32.SetCapture(source)
+	state.timer = w32.SetTimer(0, 0, dragScrollInterval, 0)
+	gDrag = state
+}
+
+// handleDragMessage runs the drag in progress. It is called from the message loop
+// and reports whether msg was used.
+func handleDragMessage(msg *w32.MSG) bool {
+	state := gDrag
+	if state == nil {
+		return false
+	}
+	switch msg.Message {
+	case w32.WM_MOUSEMOVE:
+		state.move()
+		return true
+	case w32.WM_LBUTTONUP:
+		state.end(true)
+		return true
+	case w32.WM_RBUTTONDOWN:
+		state.end(false)
+		return true
+	case w32.WM_KEYDOWN:
+		if msg.WParam == w32.VK_ESCAPE {
+			state.end(false)
+		}
+		return true
+	case w32.WM_TIMER:
+		if msg.Hwnd != 0 || msg.WParam != state.timer {
+			return false
+		}
+		// The button came up outside, or capture was lost.
+		if w32.GetKeyState(w32.VK_LBUTTON) >= 0 {
+			state.end(false)
+		} else {
+			state.scroll()
+		}
+		return true
+	}
+	return false
+}
+
+// targetAt returns the drop target under the screen point x, y: the window
+// on top there, or the closest of its parents that is a drop target.
+func targetAt(x, y int) dropTarget {
+	for hwnd := w32.WindowFromPoint(x, y); hwnd != 0; hwnd = w32.GetParent(hwnd) {
+		if target, ok := gDropTargets[hwnd]; ok {
+			return target
+		}
+	}
+	return nil
+}
+
+func (state *dragState) move() {
+	x, y, _ := w32.GetCursorPos()
+	w32.ImageList_DragShowNolock(false)
+	defer w32.ImageList_DragShowNolock(true)
+	w32.ImageList_DragMove(x, y)
+
+	if state.target != nil && state.canDrop {
+		state.target.highlightDrop(&state.drag, false)
+	}
+	state.target, state.canDrop = targetAt(x, y), false
+	state.drag.Target, state.drag.TreeItem, state.drag.ListItem, state.drag.Index = nil, nil, nil, 0
+	if state.target != nil {
+		clientX, clientY, _ := w32.ScreenToClient(state.target.Handle(), x, y)
+		state.drag.Target = state.target
+		state.target.dropAt(&state.drag, clientX, clientY)
+		state.canDrop = state.target.dropHandler().CanDrop(&state.drag)
+	}
+
+	if state.canDrop {
+		state.target.highlightDrop(&state.drag, true)
+		w32.SetCursor(w32.LoadCursor(0, w32.MakeIntResource(w32.IDC_ARROW)))
+	} else {
+		w32.SetCursor(w32.LoadCursor(0, w32.MakeIntResource(w32.IDC_NO)))
+	}
+}
+
+// scroll the target when the cursor is near its top or bottom edge.
+func (state *dragState) scroll() {
+	if state.target == nil {
+		return
+	}
+	x, y, _ := w32.GetCursorPos()
+	hwnd := state.target.Handle()
+	_, clientY, _ := w32.ScreenToClient(hwnd, x, y)
+	rect := w32.GetClientRect(hwnd)
+
+	var code uintptr
+	switch dragScrollDirection(clientY, int(rect.Bottom-rect.Top)) {
+	case -1:
+		code = w32.SB_LINEUP
+	case 1:
+		code = w32.SB_LINEDOWN
+	default:
+		return
+	}
+	w32.ImageList_DragShowNolock(false)
+	w32.SendMessage(hwnd, w32.WM_VSCROLL, code, 0)
+	w32.ImageList_DragShowNolock(true)
+	state.move()
+}
+
+// end the drag, dropping the items if drop and the target accepts them.
+func (state *dragState) end(drop bool) {
+	gDrag = nil
+	w32.KillTimer(0, state.timer)
+	if state.image != 0 {
+		w32.ImageList_DragLeave(0)
+		w32.ImageList_EndDrag()
+		w32.ImageList_Destroy(state.image)
+	}
+	w32.ReleaseCapture()
+
+	if state.target != nil && state.canDrop {
+		state.target.highlightDrop(&state.drag, false)
+		if drop {
+			state.target.dropHandler().Drop(&state.drag)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"unsafe"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// EnableDrag lets the user drag items to a TreeView or ListView with a DropHandler.
+func (tv *TreeView) EnableDrag(enable bool) {
+	tv.dragEnabled = enable
+	ToggleStyle(tv.hwnd, !enable, w32.TVS_DISABLEDRAGDROP)
+}
+
+// SetDropHandler lets items be dropped on the tree view. A nil handler refuses drops.
+func (tv *TreeView) SetDropHandler(handler DropHandler) {
+	tv.drop = handler
+	setDropHandler(tv, handler)
+}
+
+func (tv *TreeView) dropHandler() DropHandler {
+	return tv.drop
+}
+
+func (tv *TreeView) dropAt(drag *DragDrop, x, y int) {
+	drag.TreeItem = tv.ItemAt(x, y)
+}
+
+func (tv *TreeView) highlightDrop(drag *DragDrop, show bool) {
+	var hItem w32.HTREEITEM
+	if info := tv.item2Info[drag.TreeItem]; show && info != nil {
+		hItem = info.handle
+	}
+	w32.SendMessage(tv.hwnd, w32.TVM_SELECTITEM, w32.TVGN_DROPHILITE, uintptr(hItem))
+}
+
+// EnableDrag lets the user drag the selected items to a TreeView or ListView with a DropHandler.
+func (control *ListView) EnableDrag(enable bool) {
+	control.dragEnabled = enable
+}
+
+// SetDropHandler lets items be dropped on the list view. A nil handler refuses drops.
+func (control *ListView) SetDropHandler(handler DropHandler) {
+	control.drop = handler
+	setDropHandler(control, handler)
+}
+
+func (control *ListView) dropHandler() DropHandler {
+	return control.drop
+}
+
+func (control *ListView) dropAt(drag *DragDrop, x, y int) {
+	hti := w32.LVHITTESTINFO{Pt: w32.POINT{X: int32(x), Y: int32(y)}}
+	index := int(w32.SendMessage(control.hwnd, w32.LVM_HITTEST, 0, uintptr(unsafe.Pointer(&hti))))
+	if index < 0 {
+		drag.Index, drag.ListItem = control.ItemCount(), nil
+	} else {
+		drag.Index, drag.ListItem = index, control.findItemByIndex(index)
+	}
+}
+
+func (control *ListView) highlightDrop(drag *DragDrop, show bool) {
+	// Index -1 clears every row.
+	control.setItemState(-1, 0, w32.LVIS_DROPHILITED)
+	if show && drag.ListItem != nil {
+		control.setItemState(drag.Index, w32.LVIS_DROPHILITED, w32.LVIS_DROPHILITED)
+	}
+}
@@ -95,6 +95,22 @@ func (control *Edit) SetPassword(isPassword bool) {
 	}
 }
 
+// Edit fields are sized by character count, text is scrolled when longer.
+const (
+	editPreferredChars = 20
+	editMinimumChars   = 4
+)
+
+func (control *Edit) PreferredSize() (width, height int) {
+	text_width, _ := control.textSize(control.Text())
+	text_width = max(text_width, editPreferredChars*control.measureFont().AverageCharWidth())
+	return control.clampSize(control.fieldSize(text_width))
+}
+
+func (control *Edit) MinimumSize() (width, height int) {
+	return control.clampSize(control.fieldSize(editMinimumChars * control.measureFont().AverageCharWidth()))
+}
+
 func (control *Edit) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	switch msg {
 	case w32.WM_COMMAND:
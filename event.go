@@ -8,8 +8,16 @@ package windigo
 type Event struct {
 	Sender Controller
 	Data   interface{}
+
+	// Handled stops the event from reaching the remaining handlers.
+	Handled bool
 }
 
 func NewEvent(sender Controller, data interface{}) *Event {
 	return &Event{Sender: sender, Data: data}
 }
+
+// SetHandled marks the event handled.
+func (event *Event) SetHandled() {
+	event.Handled = true
+}
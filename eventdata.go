@@ -44,6 +44,16 @@ type KeyUpEventData struct {
 	VKey, Code int
 }
 
+// ChordEventData is sent by Form.OnChordPending.
+type ChordEventData struct {
+	Pending Chord // keys pressed so far, empty when the chord is done or canceled
+}
+
+// HotKeyEventData is sent by Form.OnHotKey.
+type HotKeyEventData struct {
+	Shortcut Shortcut
+}
+
 type SizeEventData struct {
 	Type uint
 	X, Y int
@@ -52,3 +62,10 @@ type SizeEventData struct {
 type ListViewEvent struct {
 	Row, Column int
 }
+
+// CellEditEventData is sent by ListView.OnCellEdited.
+type CellEditEventData struct {
+	ListViewEvent
+	Item             ListItem
+	OldText, NewText string
+}
@@ -7,16 +7,100 @@ package windigo
 
 type EventHandler func(arg *Event)
 
-type EventManager struct {
+type eventSubscriber struct {
+	id      int
 	handler EventHandler
+	removed bool
+}
+
+// EventManager calls its handlers in the order they subscribed,
+// until one of them marks the event handled.
+// The subscribers slice is copied on write, so Fire can range over it
+// while handlers subscribe or unsubscribe.
+type EventManager struct {
+	subscribers []*eventSubscriber
+	lastID      int
+	boundID     int
+}
+
+// Subscription identifies a handler added with Subscribe.
+type Subscription struct {
+	evm *EventManager
+	id  int
+}
+
+// Unsubscribe removes the handler. It is safe to call more than once
+// and from within a handler.
+func (sub Subscription) Unsubscribe() {
+	if sub.evm != nil {
+		sub.evm.remove(sub.id)
+	}
 }
 
 func (evm *EventManager) Fire(arg *Event) {
-	if evm.handler != nil {
-		evm.handler(arg)
+	// Handlers subscribed during dispatch are called from the next Fire,
+	// handlers unsubscribed during dispatch are not called anymore.
+	for _, s := range evm.subscribers {
+		if s.removed {
+			continue
+		}
+		s.handler(arg)
+		if arg != nil && arg.Handled {
+			return
+		}
 	}
 }
 
+// Subscribe adds handler after the existing ones.
+func (evm *EventManager) Subscribe(handler EventHandler) Subscription {
+	if handler == nil {
+		return Subscription{}
+	}
+	evm.lastID++
+	subscribers := make([]*eventSubscriber, len(evm.subscribers), len(evm.subscribers)+1)
+	copy(subscribers, evm.subscribers)
+	evm.subscribers = append(subscribers, &eventSubscriber{id: evm.lastID, handler: handler})
+	return Subscription{evm, evm.lastID}
+}
+
+// subscribeFirst adds handler before the existing ones.
+func (evm *EventManager) subscribeFirst(handler EventHandler) Subscription {
+	evm.lastID++
+	evm.subscribers = append([]*eventSubscriber{{id: evm.lastID, handler: handler}}, evm.subscribers...)
+	return Subscription{evm, evm.lastID}
+}
+
+// Bind replaces the handler set by the previous Bind and keeps its place in the order.
+// Handlers added with Subscribe are not affected. Bind(nil) removes the bound handler.
 func (evm *EventManager) Bind(handler EventHandler) {
-	evm.handler = handler
+	if handler == nil {
+		evm.remove(evm.boundID)
+		evm.boundID = 0
+		return
+	}
+	for i, s := range evm.subscribers {
+		if evm.boundID != 0 && s.id == evm.boundID {
+			subscribers := append([]*eventSubscriber(nil), evm.subscribers...)
+			subscribers[i] = &eventSubscriber{id: s.id, handler: handler}
+			s.removed = true
+			evm.subscribers = subscribers
+			return
+		}
+	}
+	evm.boundID = evm.Subscribe(handler).id
+}
+
+// HasHandlers reports whether any handler is bound or subscribed.
+func (evm *EventManager) HasHandlers() bool {
+	return len(evm.subscribers) > 0
+}
+
+func (evm *EventManager) remove(id int) {
+	for i, s := range evm.subscribers {
+		if s.id == id {
+			s.removed = true
+			evm.subscribers = append(evm.subscribers[:i:i], evm.subscribers[i+1:]...)
+			return
+		}
+	}
 }
@@ -0,0 +1,24 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// See core.FlowDirection.
+type FlowDirection = core.FlowDirection
+
+const (
+	FlowLeftToRight = core.FlowLeftToRight
+	FlowTopToBottom = core.FlowTopToBottom
+)
+
+// See core.FlowLayout.
+type FlowLayout = core.FlowLayout
+
+func NewFlowLayout(parent DockAllow) *FlowLayout {
+	flow := core.NewFlowLayout(parent)
+	parent.SetLayout(flow)
+	return flow
+}
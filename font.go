@@ -6,17 +6,19 @@
 package windigo
 
 import (
+	"strings"
 	"syscall"
 
+	"github.com/samuel-jimenez/windigo/core"
 	"github.com/samuel-jimenez/windigo/w32"
 )
 
 const (
-	FontNormal    byte = 0x00
-	FontBold      byte = 0x01
-	FontItalic    byte = 0x02
-	FontUnderline byte = 0x04
-	FontStrikeOut byte = 0x08
+	FontNormal    = core.FontNormal
+	FontBold      = core.FontBold
+	FontItalic    = core.FontItalic
+	FontUnderline = core.FontUnderline
+	FontStrikeOut = core.FontStrikeOut
 )
 
 func init() {
@@ -85,6 +87,48 @@ func (fnt *Font) createForDPI(dpi int) w32.HFONT {
 	return w32.CreateFontIndirect(&lf)
 }
 
+// withDC selects the font into a screen DC for measuring.
+func (fnt *Font) withDC(measure func(hdc w32.HDC)) {
+	hDC := w32.GetDC(0)
+	defer w32.ReleaseDC(0, hDC)
+
+	previousFont := w32.SelectObject(hDC, w32.HGDIOBJ(fnt.hfont))
+	defer w32.SelectObject(hDC, previousFont)
+
+	measure(hDC)
+}
+
+// Metrics of the font on screen.
+func (fnt *Font) Metrics() (tm w32.TEXTMETRIC) {
+	fnt.withDC(func(hdc w32.HDC) {
+		w32.GetTextMetrics(hdc, &tm)
+	})
+	return
+}
+
+// AverageCharWidth is the width of an average character, useful to size controls by character count.
+func (fnt *Font) AverageCharWidth() int {
+	return int(fnt.Metrics().TmAveCharWidth)
+}
+
+// TextSize measures text drawn in the font. Lines are separated by "\n".
+func (fnt *Font) TextSize(text string) (width, height int) {
+	fnt.withDC(func(hdc w32.HDC) {
+		var tm w32.TEXTMETRIC
+		w32.GetTextMetrics(hdc, &tm)
+		for line := range strings.SplitSeq(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+			var size w32.SIZE
+			if len(line) > 0 {
+				buf := syscall.StringToUTF16(line)
+				w32.GetTextExtentPoint32(hdc, &buf[0], len(buf)-1, &size)
+			}
+			width = max(width, int(size.CX))
+			height += int(tm.TmHeight)
+		}
+	})
+	return
+}
+
 func (fnt *Font) GetHFONT() w32.HFONT {
 	return fnt.hfont
 }
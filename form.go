@@ -57,7 +57,15 @@ type Form struct {
 	previousWindowStyle     uint32
 	previousWindowPlacement w32.WINDOWPLACEMENT
 
-	local_shortcuts map[Shortcut]func() bool
+	commands     *Commands
+	accelerators []string // IDs of the commands of AddShortcut and the menu
+
+	chords         *ChordMatcher
+	chordActions   map[string]func() bool
+	onChordPending EventManager
+
+	hotKeys  map[Shortcut]int32 // by id of RegisterHotKey
+	onHotKey EventManager
 }
 
 func NewCustomForm(parent Controller, exStyle int, dwStyle uint) *Form {
@@ -77,7 +85,7 @@ func NewCustomForm(parent Controller, exStyle int, dwStyle uint) *Form {
 
 	control.hwnd = CreateWindow("windigo_Form", parent, uint(exStyle), dwStyle)
 	control.parent = parent
-	control.local_shortcuts = make(map[Shortcut]func() bool)
+	control.commands = NewCommands()
 
 	// this might fail if icon resource is not embedded in the binary
 	if ico, err := NewIconFromResource(GetAppInstance(), uint16(AppIconID)); err == nil {
@@ -111,7 +119,7 @@ func (control *Form) NewMenu() *Menu {
 	if hMenu == 0 {
 		panic("failed CreateMenu")
 	}
-	m := &Menu{hMenu: hMenu, hwnd: control.hwnd}
+	m := &Menu{hMenu: hMenu, hwnd: control.hwnd, form: control}
 	if !w32.SetMenu(control.hwnd, hMenu) {
 		panic("failed SetMenu")
 	}
@@ -238,8 +246,37 @@ func (control *Form) EnableTopMost(b bool) {
 	w32.SetWindowPos(control.hwnd, tag, 0, 0, 0, 0, w32.SWP_NOMOVE|w32.SWP_NOSIZE)
 }
 
+// AddShortcut runs action when shortcut is pressed; action returns false to leave the key
+// to the focused control. It is a command of Commands with the ID "shortcut:" and
+// the shortcut, so it can be listed and rebound. Adding the shortcut again replaces action.
 func (control *Form) AddShortcut(shortcut Shortcut, action func() bool) {
-	control.local_shortcuts[shortcut] = action
+	id := "shortcut:" + shortcut.String()
+	if cmd := control.commands.Command(id); cmd != nil {
+		cmd.Handle = action
+		return
+	}
+	control.commands.Register(Command{ID: id, Title: shortcut.String(), Shortcut: shortcut, Handle: action})
+	control.accelerators = append(control.accelerators, id)
+}
+
+// SetCommands runs the commands by their shortcuts and by their chords, along with those of AddChord.
+// The commands of AddShortcut and of menu items with a shortcut move to commands, after those
+// registered already. A shortcut bound to several commands runs the first enabled, see Commands.Conflicts.
+// It updates their menu items when a menu opens.
+func (control *Form) SetCommands(commands *Commands) {
+	if commands == nil {
+		commands = NewCommands()
+	}
+	for _, id := range control.accelerators {
+		commands.adopt(control.commands, id)
+	}
+	control.commands = commands
+	control.chordMatcher()
+}
+
+// Commands returns the commands of the form, with those of AddShortcut and of the menu.
+func (control *Form) Commands() *Commands {
+	return control.commands
 }
 
 func (control *Form) PreTranslateMessage(msg *w32.MSG) bool {
@@ -248,15 +285,8 @@ func (control *Form) PreTranslateMessage(msg *w32.MSG) bool {
 		// Shortcut support.
 		key := Key(msg.WParam)
 		if uint32(msg.LParam)>>30 == 0 {
-			shortcut := Shortcut{ModifiersDown(), key}
-			if action, ok := control.local_shortcuts[shortcut]; ok {
-				return action()
-			} else if action, ok := shortcut2Action[shortcut]; ok {
-				if action.Enabled() {
-					action.onClick.Fire(NewEvent(control, msg.Pt))
-					return true
-				}
-			}
+			shortcut := Shortcut{Modifiers: ModifiersDown(), Key: key}
+			return control.pressKey(shortcut, msg.Pt)
 		}
 	}
 	return false
@@ -273,9 +303,16 @@ func (control *Form) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 				action.onClick.Fire(NewEvent(control, nil))
 			}
 		}
+	case w32.WM_TIMER:
+		control.chordTimer(wparam)
+	case w32.WM_HOTKEY:
+		control.hotKey(int32(wparam))
+	case w32.WM_INITMENUPOPUP:
+		control.commands.Update()
 	case w32.WM_CLOSE:
 		return 0
 	case w32.WM_DESTROY:
+		control.UnregisterHotKeys()
 		w32.PostQuitMessage(0)
 		return 0
 
@@ -0,0 +1,127 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"time"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// DefaultChordTimeout is the time allowed between the keys of a chord.
+const DefaultChordTimeout = 2 * time.Second
+
+// chordTimerID is the WM_TIMER id of the chord timeout.
+const chordTimerID = 0x43484F52
+
+// AddChord runs action when the shortcuts of chord are pressed one after the other.
+// Chords are matched before the shortcuts of AddShortcut, and a pending chord
+// is canceled by Escape, a key that does not continue it, or the timeout.
+// A chord that starts a longer one runs when the next key does not continue
+// it, or when the timeout passes.
+func (control *Form) AddChord(chord Chord, action func() bool) {
+	control.chordMatcher().Add(chord)
+	control.chordActions[chord.String()] = action
+}
+
+// SetChordTimeout sets the time allowed between the keys of a chord, 0 waits forever.
+func (control *Form) SetChordTimeout(timeout time.Duration) {
+	control.chordMatcher().Timeout = timeout
+}
+
+// chordMatcher returns the chords, created on first use. They include the
+// shortcuts and chords of the commands, so a shortcut is not run while it
+// may start a longer chord.
+func (control *Form) chordMatcher() *ChordMatcher {
+	if control.chords == nil {
+		control.chords = NewChordMatcher(DefaultChordTimeout)
+		control.chords.Extra = func() []Chord {
+			return control.commands.Chords()
+		}
+		control.chordActions = make(map[string]func() bool)
+	}
+	return control.chords
+}
+
+// CancelChord cancels the keys pressed of a chord.
+func (control *Form) CancelChord() {
+	if control.chords != nil && len(control.chords.Pending()) > 0 {
+		control.chords.Cancel()
+		control.chordPending()
+	}
+}
+
+// OnChordPending fires when a key of a chord is pressed, and when the chord
+// is done or canceled, to show the keys pressed so far. Event.Data is a *ChordEventData.
+func (control *Form) OnChordPending() *EventManager {
+	return &control.onChordPending
+}
+
+// pressKey runs what shortcut is bound to, as part of a chord or on its own,
+// and reports whether it was used.
+func (control *Form) pressKey(shortcut Shortcut, pt w32.POINT) bool {
+	if control.chords == nil {
+		return control.runShortcut(shortcut, pt)
+	}
+	pending := len(control.chords.Pending())
+	result, chord := control.chords.Press(shortcut, time.Now())
+	if len(control.chords.Pending()) != pending {
+		control.chordPending()
+	}
+	switch result {
+	case ChordNone:
+		return control.runShortcut(shortcut, pt)
+	case ChordMatched:
+		return control.runChord(chord, pt)
+	case ChordMatchedPending:
+		control.runChord(chord, pt)
+		return control.pressKey(shortcut, pt)
+	}
+	return true
+}
+
+// runChord runs the action of chord: one of AddChord, or else a command.
+func (control *Form) runChord(chord Chord, pt w32.POINT) bool {
+	if action, ok := control.chordActions[chord.String()]; ok {
+		return action()
+	}
+	if len(chord) == 1 {
+		return control.runShortcut(chord[0], pt)
+	}
+	shortcutForm, shortcutPoint = control, pt
+	return control.commands.RunChord(chord)
+}
+
+// runShortcut runs the command bound to shortcut, or else the context menu item.
+func (control *Form) runShortcut(shortcut Shortcut, pt w32.POINT) bool {
+	shortcutForm, shortcutPoint = control, pt
+	return control.commands.RunShortcut(shortcut) || contextMenuCommands.RunShortcut(shortcut)
+}
+
+// chordPending sets the timeout timer and fires OnChordPending.
+func (control *Form) chordPending() {
+	pending := control.chords.Pending()
+	if len(pending) > 0 && control.chords.Timeout > 0 {
+		w32.SetTimer(control.hwnd, chordTimerID, uint32(control.chords.Timeout/time.Millisecond), 0)
+	} else {
+		w32.KillTimer(control.hwnd, chordTimerID)
+	}
+	control.onChordPending.Fire(NewEvent(control, &ChordEventData{pending}))
+}
+
+// chordTimer ends a chord whose timeout passed, running it if the keys are one.
+// The timer repeats until it does.
+func (control *Form) chordTimer(id uintptr) {
+	if id != chordTimerID || control.chords == nil {
+		return
+	}
+	result, chord := control.chords.Expire(time.Now())
+	if result != ChordNone {
+		control.chordPending()
+	}
+	if result == ChordMatched {
+		control.runChord(chord, w32.POINT{})
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// ErrHotKeyTaken is returned by RegisterHotKey when another program has the hot key.
+var ErrHotKeyTaken = errors.New("hot key is already registered by another program")
+
+// RegisterHotKey makes shortcut fire OnHotKey system wide, even when another program
+// has the focus, until UnregisterHotKey or Close. Holding the keys does not repeat it.
+func (control *Form) RegisterHotKey(shortcut Shortcut) error {
+	if shortcut.Key == 0 {
+		return errors.New("hot key has no key")
+	}
+	if _, ok := control.hotKeys[shortcut]; ok {
+		return nil
+	}
+
+	var modifiers uint32 = w32.MOD_NOREPEAT
+	if shortcut.Modifiers&ModAlt != 0 {
+		modifiers |= w32.MOD_ALT
+	}
+	if shortcut.Modifiers&ModControl != 0 {
+		modifiers |= w32.MOD_CONTROL
+	}
+	if shortcut.Modifiers&ModShift != 0 {
+		modifiers |= w32.MOD_SHIFT
+	}
+	if shortcut.Modifiers&ModWin != 0 {
+		modifiers |= w32.MOD_WIN
+	}
+
+	id, err := control.freeHotKeyID()
+	if err != nil {
+		return err
+	}
+	if err := w32.RegisterHotKey(control.hwnd, id, modifiers, uint32(shortcut.Key)); err != nil {
+		if errno, ok := err.(syscall.Errno); ok && errno == w32.ERROR_HOTKEY_ALREADY_REGISTERED {
+			return fmt.Errorf("%s: %w", shortcut, ErrHotKeyTaken)
+		}
+		return fmt.Errorf("RegisterHotKey %s: %w", shortcut, err)
+	}
+	if control.hotKeys == nil {
+		control.hotKeys = make(map[Shortcut]int32)
+	}
+	control.hotKeys[shortcut] = id
+	return nil
+}
+
+// freeHotKeyID returns the lowest id no hot key uses. Programs use ids up to 0xBFFF.
+func (control *Form) freeHotKeyID() (int32, error) {
+	used := make(map[int32]bool, len(control.hotKeys))
+	for _, id := range control.hotKeys {
+		used[id] = true
+	}
+	for id := int32(0); id < 0xC000; id++ {
+		if !used[id] {
+			return id, nil
+		}
+	}
+	return 0, errors.New("no free hot key id")
+}
+
+func (control *Form) UnregisterHotKey(shortcut Shortcut) {
+	if id, ok := control.hotKeys[shortcut]; ok {
+		w32.UnregisterHotKey(control.hwnd, id)
+		delete(control.hotKeys, shortcut)
+	}
+}
+
+// UnregisterHotKeys unregisters all hot keys. Close and WM_DESTROY call it.
+func (control *Form) UnregisterHotKeys() {
+	for shortcut := range control.hotKeys {
+		control.UnregisterHotKey(shortcut)
+	}
+}
+
+// HotKeys returns the registered hot keys.
+func (control *Form) HotKeys() []Shortcut {
+	shortcuts := make([]Shortcut, 0, len(control.hotKeys))
+	for shortcut := range control.hotKeys {
+		shortcuts = append(shortcuts, shortcut)
+	}
+	return shortcuts
+}
+
+// OnHotKey fires when a hot key of RegisterHotKey is pressed. Event.Data is a *HotKeyEventData.
+func (control *Form) OnHotKey() *EventManager {
+	return &control.onHotKey
+}
+
+func (control *Form) Close() {
+	control.UnregisterHotKeys()
+	control.ControlBase.Close()
+}
+
+func (control *Form) hotKey(id int32) {
+	for shortcut, hotKeyID := range control.hotKeys {
+		if hotKeyID == id {
+			control.onHotKey.Fire(NewEvent(control, &HotKeyEventData{shortcut}))
+			return
+		}
+	}
+}
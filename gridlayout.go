@@ -0,0 +1,41 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// See core.GridUnit.
+type GridUnit = core.GridUnit
+
+const (
+	GridUnitAuto  = core.GridUnitAuto
+	GridUnitFixed = core.GridUnitFixed
+	GridUnitStar  = core.GridUnitStar
+)
+
+// See core.GridLength.
+type GridLength = core.GridLength
+
+var GridAuto = core.GridAuto
+
+func GridFixed(size int) GridLength {
+	return core.GridFixed(size)
+}
+
+func GridStar(weight int) GridLength {
+	return core.GridStar(weight)
+}
+
+// See core.GridCell.
+type GridCell = core.GridCell
+
+// See core.GridLayout.
+type GridLayout = core.GridLayout
+
+func NewGridLayout(parent DockAllow) *GridLayout {
+	grid := core.NewGridLayout(parent)
+	parent.SetLayout(grid)
+	return grid
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"syscall"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+var (
+	gInvokeMsg   = w32.RegisterWindowMessage("windigo_Invoke")
+	gInvokeQueue = NewDispatchQueue(func() {
+		w32.PostMessage(gInvokeHwnd, gInvokeMsg, 0, 0)
+	})
+	// gInvokeHwnd is a message-only window that wakes the message loop.
+	// RunMainLoop and PostMessages drain gInvokeQueue after each message;
+	// the window drains it too, as modal loops dispatch its messages but
+	// do not return to RunMainLoop.
+	gInvokeHwnd w32.HWND
+)
+
+func init() {
+	const className = "windigo_Invoke"
+	RegisterClass(className, syscall.NewCallback(invokeWndProc))
+	gInvokeHwnd = w32.CreateWindowEx(0, syscall.StringToUTF16Ptr(className), nil, 0,
+		0, 0, 0, 0, w32.HWND_MESSAGE, 0, GetAppInstance(), nil)
+	if gInvokeHwnd == 0 {
+		panic("Error occurred in CreateWindow(" + className + ")")
+	}
+}
+
+func invokeWndProc(hwnd w32.HWND, msg uint32, wparam, lparam uintptr) uintptr {
+	if msg == gInvokeMsg {
+		drainInvokes()
+		return 0
+	}
+	return w32.DefWindowProc(hwnd, msg, wparam, lparam)
+}
+
+// drainInvokes runs the functions queued by Invoke and BeginInvoke.
+func drainInvokes() {
+	if gInvokeQueue.Pending() > 0 {
+		gInvokeQueue.Drain()
+	}
+}
+
+// IsUIThread reports whether the caller runs on the thread that owns the windows.
+func IsUIThread() bool {
+	return w32.GetCurrentThreadId() == w32.MainThread()
+}
+
+// Invoke runs fn on the UI thread and waits for it to finish.
+// On the UI thread fn runs right away.
+func Invoke(fn func()) {
+	if IsUIThread() {
+		fn()
+		return
+	}
+	gInvokeQueue.Invoke(fn)
+}
+
+// BeginInvoke queues fn to run on the UI thread and returns at once.
+func BeginInvoke(fn func()) {
+	gInvokeQueue.BeginInvoke(fn)
+}
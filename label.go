@@ -24,6 +24,14 @@ func NewLabel(parent Controller) *Label {
 	return lb
 }
 
+func (lb *Label) PreferredSize() (width, height int) {
+	return lb.clampSize(lb.textSize(lb.Text()))
+}
+
+func (lb *Label) MinimumSize() (width, height int) {
+	return lb.PreferredSize()
+}
+
 func (lb *Label) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	return w32.DefWindowProc(lb.hwnd, msg, wparam, lparam)
 }
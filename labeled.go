@@ -29,6 +29,11 @@ type Labeled struct {
 
 func (control Labeled) Label() *Label { return control.FieldLabel }
 
+// labeledSize places the label left of the field.
+func labeledSize(label_width, label_height, field_width, field_height int) (width, height int) {
+	return label_width + field_width, max(label_height, field_height)
+}
+
 /* Labelable
  *
  */
@@ -90,6 +95,18 @@ func (control *LabeledEdit) SetFont(font *Font) {
 	control.Edit.SetFont(font)
 	control.Label().SetFont(font)
 }
+func (control *LabeledEdit) PreferredSize() (width, height int) {
+	label_width, label_height := control.Label().PreferredSize()
+	field_width, field_height := control.Edit.PreferredSize()
+	return labeledSize(label_width, label_height, field_width, field_height)
+}
+
+func (control *LabeledEdit) MinimumSize() (width, height int) {
+	label_width, label_height := control.Label().MinimumSize()
+	field_width, field_height := control.Edit.MinimumSize()
+	return labeledSize(label_width, label_height, field_width, field_height)
+}
+
 func (control *LabeledEdit) SetLabeledSize(label_width, control_width, height int) {
 	control.SetSize(label_width+control_width, height)
 	control.Label().SetSize(label_width, height)
@@ -185,6 +202,18 @@ func (control *LabeledComboBox) SetFont(font *Font) {
 	control.Label().SetFont(font)
 }
 
+func (control *LabeledComboBox) PreferredSize() (width, height int) {
+	label_width, label_height := control.Label().PreferredSize()
+	field_width, field_height := control.ComboBox.PreferredSize()
+	return labeledSize(label_width, label_height, field_width, field_height)
+}
+
+func (control *LabeledComboBox) MinimumSize() (width, height int) {
+	label_width, label_height := control.Label().MinimumSize()
+	field_width, field_height := control.ComboBox.MinimumSize()
+	return labeledSize(label_width, label_height, field_width, field_height)
+}
+
 func (control *LabeledComboBox) SetLabeledSize(label_width, control_width, height int) {
 	control.SetSize(label_width+control_width, height)
 	control.Label().SetSize(label_width, height)
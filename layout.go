@@ -11,6 +11,7 @@ import (
 	"os"
 	"unsafe"
 
+	"github.com/samuel-jimenez/windigo/core"
 	"github.com/samuel-jimenez/windigo/w32"
 )
 
@@ -56,6 +57,7 @@ type Padded interface {
 
 // Dockable components can be docked.
 type Dockable interface {
+	LayoutNode
 	Marginal
 	Handle() w32.HWND
 
@@ -80,9 +82,8 @@ type Dockable interface {
 
 // DockAllow is window, panel or other component that allows children to be docked
 type DockAllow interface {
+	LayoutContainer
 	Handle() w32.HWND
-	ClientWidth() int
-	ClientHeight() int
 	SetLayout(mng LayoutManager)
 }
 
@@ -94,41 +95,40 @@ type ComponentFrame interface {
 }
 
 // Various layout managers
-type Direction int
+type Direction = core.Direction
 
 const (
-	Top Direction = iota
-	TopLeft
-	TopCenter
-	TopRight
-	Bottom
-	BottomLeft
-	BottomCenter
-	BottomRight
-	Left
-	LeftTop
-	LeftCenter
-	LeftBottom
-	Right
-	RightTop
-	RightCenter
-	RightBottom
-	Fill
-	Center
+	Top          = core.Top
+	TopLeft      = core.TopLeft
+	TopCenter    = core.TopCenter
+	TopRight     = core.TopRight
+	Bottom       = core.Bottom
+	BottomLeft   = core.BottomLeft
+	BottomCenter = core.BottomCenter
+	BottomRight  = core.BottomRight
+	Left         = core.Left
+	LeftTop      = core.LeftTop
+	LeftCenter   = core.LeftCenter
+	LeftBottom   = core.LeftBottom
+	Right        = core.Right
+	RightTop     = core.RightTop
+	RightCenter  = core.RightCenter
+	RightBottom  = core.RightBottom
+	Fill         = core.Fill
+	Center       = core.Center
 )
 
 type LayoutControl struct {
-	child Dockable
+	child LayoutNode
 	dir   Direction
+	name  string
 }
 
 type LayoutControls []*LayoutControl
 
-// DockState gets saved and loaded from json
-type CtlState struct {
-	X, Y, Width, Height int
-}
-
+// LayoutState is the version 0 format, kept for reading old files.
+//
+// Deprecated: SaveState writes DockState.
 type LayoutState struct {
 	WindowState string
 	Controls    []*CtlState
@@ -151,9 +151,10 @@ type Dockerable interface {
  *
  */
 type SimpleDock struct {
-	parent      DockAllow
-	layoutCtl   LayoutControls
-	loadedState bool
+	parent        LayoutContainer
+	layoutCtl     LayoutControls
+	loadedState   bool
+	sizeToContent bool
 
 	padding_top, padding_btm,
 	padding_left, padding_right int
@@ -165,9 +166,56 @@ func NewSimpleDock(parent DockAllow) *SimpleDock {
 	return d
 }
 
+// NewHeadlessDock creates a dock that lays out nodes within parent without any window.
+// Call Update after the parent or nodes change.
+func NewHeadlessDock(parent LayoutContainer) *SimpleDock {
+	return &SimpleDock{parent: parent}
+}
+
 // Layout management for the child controls.
 func (control *SimpleDock) Dock(child Dockable, dir Direction) {
-	control.layoutCtl = append(control.layoutCtl, &LayoutControl{child, dir})
+	control.DockNode(child, dir)
+}
+
+// DockNode docks any LayoutNode, including ones without a window.
+func (control *SimpleDock) DockNode(child LayoutNode, dir Direction) {
+	control.layoutCtl = append(control.layoutCtl, &LayoutControl{child: child, dir: dir})
+}
+
+// DockAs docks child under a name that identifies it in saved layout state.
+// Children docked without a name are identified by their index, so names
+// may not start with '#' and must be unique within the dock.
+func (control *SimpleDock) DockAs(child Dockable, dir Direction, name string) error {
+	used := make([]string, len(control.layoutCtl))
+	for i, c := range control.layoutCtl {
+		used[i] = c.name
+	}
+	if err := core.CheckStateName(name, used); err != nil {
+		return err
+	}
+	control.layoutCtl = append(control.layoutCtl, &LayoutControl{child: child, dir: dir, name: name})
+	return nil
+}
+
+// SetSizeToContent makes the dock start from the preferred size of its children
+// instead of their current size.
+func (control *SimpleDock) SetSizeToContent(enable bool) {
+	control.sizeToContent = enable
+}
+
+// ContentSize is the smallest client size that fits the docked children at their size.
+func (control *SimpleDock) ContentSize() (width, height int) {
+	width, height = core.DockContentSize(control.dockItems(), control.sizeToContent)
+	return width + control.padding_left + control.padding_right,
+		height + control.padding_top + control.padding_btm
+}
+
+func (control *SimpleDock) dockItems() []core.DockItem {
+	items := make([]core.DockItem, len(control.layoutCtl))
+	for i, c := range control.layoutCtl {
+		items[i] = core.DockItem{Node: c.child, Dir: c.dir}
+	}
+	return items
 }
 
 // Padded
@@ -222,31 +270,39 @@ func (control *SimpleDock) SetPaddingRight(padding int) {
 	control.padding_right = padding
 }
 
-// SaveState of the layout. Only works for Docks with parent set to main form.
-func (control *SimpleDock) SaveState(w io.Writer) error {
-	var ls LayoutState
-
-	var wp w32.WINDOWPLACEMENT
-	wp.Length = uint32(unsafe.Sizeof(wp))
-	if !w32.GetWindowPlacement(control.parent.Handle(), &wp) {
-		return fmt.Errorf("GetWindowPlacement failed")
+// topLevel returns the window of the dock parent if it is a top level window.
+func (control *SimpleDock) topLevel() (w32.HWND, bool) {
+	parent, ok := control.parent.(DockAllow)
+	if !ok {
+		return 0, false
 	}
+	hwnd := parent.Handle()
+	return hwnd, hwnd != 0 && w32.GetWindowLong(hwnd, w32.GWL_STYLE)&w32.WS_CHILD == 0
+}
 
-	ls.WindowState = fmt.Sprint(
-		wp.Flags, wp.ShowCmd,
-		wp.PtMinPosition.X, wp.PtMinPosition.Y,
-		wp.PtMaxPosition.X, wp.PtMaxPosition.Y,
-		wp.RcNormalPosition.Left, wp.RcNormalPosition.Top,
-		wp.RcNormalPosition.Right, wp.RcNormalPosition.Bottom)
+// SaveState of the layout. Controls are keyed by the name given to DockAs,
+// nested docks, resizers, tab views and list views save their own state.
+// Window placement is saved if the parent is a top level window.
+func (control *SimpleDock) SaveState(w io.Writer) error {
+	ls := DockState{Version: LayoutStateVersion}
 
-	for _, c := range control.layoutCtl {
-		x, y := c.child.Pos()
-		w, h := c.child.Width(), c.child.Height()
+	if hwnd, ok := control.topLevel(); ok {
+		var wp w32.WINDOWPLACEMENT
+		wp.Length = uint32(unsafe.Sizeof(wp))
+		if !w32.GetWindowPlacement(hwnd, &wp) {
+			return fmt.Errorf("GetWindowPlacement failed")
+		}
 
-		ctl := &CtlState{X: x, Y: y, Width: w, Height: h}
-		ls.Controls = append(ls.Controls, ctl)
+		ls.WindowState = fmt.Sprint(
+			wp.Flags, wp.ShowCmd,
+			wp.PtMinPosition.X, wp.PtMinPosition.Y,
+			wp.PtMaxPosition.X, wp.PtMaxPosition.Y,
+			wp.RcNormalPosition.Left, wp.RcNormalPosition.Top,
+			wp.RcNormalPosition.Right, wp.RcNormalPosition.Bottom)
 	}
 
+	ls.Controls = control.saveControls()
+
 	if err := json.NewEncoder(w).Encode(ls); err != nil {
 		return err
 	}
@@ -254,40 +310,36 @@ func (control *SimpleDock) SaveState(w io.Writer) error {
 	return nil
 }
 
-// LoadState of the layout. Only works for Docks with parent set to main form.
+// LoadState of the layout. Older versions are migrated, see RegisterLayoutMigration.
+// Controls missing from the saved state keep their current geometry.
 func (control *SimpleDock) LoadState(r io.Reader) error {
-	var ls LayoutState
-
-	if err := json.NewDecoder(r).Decode(&ls); err != nil {
+	data, err := io.ReadAll(r)
+	if err != nil {
 		return err
 	}
-
-	var wp w32.WINDOWPLACEMENT
-	if _, err := fmt.Sscan(ls.WindowState,
-		&wp.Flags, &wp.ShowCmd,
-		&wp.PtMinPosition.X, &wp.PtMinPosition.Y,
-		&wp.PtMaxPosition.X, &wp.PtMaxPosition.Y,
-		&wp.RcNormalPosition.Left, &wp.RcNormalPosition.Top,
-		&wp.RcNormalPosition.Right, &wp.RcNormalPosition.Bottom); err != nil {
+	ls, err := core.DecodeDockState(data, len(control.layoutCtl))
+	if err != nil {
 		return err
 	}
-	wp.Length = uint32(unsafe.Sizeof(wp))
 
-	if !w32.SetWindowPlacement(control.parent.Handle(), &wp) {
-		return fmt.Errorf("SetWindowPlacement failed")
-	}
+	if hwnd, ok := control.topLevel(); ok && ls.WindowState != "" {
+		var wp w32.WINDOWPLACEMENT
+		if _, err := fmt.Sscan(ls.WindowState,
+			&wp.Flags, &wp.ShowCmd,
+			&wp.PtMinPosition.X, &wp.PtMinPosition.Y,
+			&wp.PtMaxPosition.X, &wp.PtMaxPosition.Y,
+			&wp.RcNormalPosition.Left, &wp.RcNormalPosition.Top,
+			&wp.RcNormalPosition.Right, &wp.RcNormalPosition.Bottom); err != nil {
+			return err
+		}
+		wp.Length = uint32(unsafe.Sizeof(wp))
 
-	// if number of controls in the saved layout does not match
-	// current number on screen - something changed and we do not reload
-	// rest of control sizes from json
-	if len(control.layoutCtl) != len(ls.Controls) {
-		return nil
+		if !w32.SetWindowPlacement(hwnd, &wp) {
+			return fmt.Errorf("SetWindowPlacement failed")
+		}
 	}
 
-	for i, c := range control.layoutCtl {
-		c.child.SetPos(ls.Controls[i].X, ls.Controls[i].Y)
-		c.child.SetSize(ls.Controls[i].Width, ls.Controls[i].Height)
-	}
+	control.loadControls(ls.Controls)
 	return nil
 }
 
@@ -311,83 +363,10 @@ func (control *SimpleDock) LoadStateFile(file string) error {
 
 // Update is called to resize child items based on layout directions.
 func (control *SimpleDock) Update() {
-
-	x0, x1, y0, y1 := control.padding_left, control.parent.ClientWidth()-control.padding_right, control.padding_top, control.parent.ClientHeight()-control.padding_btm
-	control_width, control_height := x1-x0,
-		y1-y0
-
-	for _, c := range control.layoutCtl {
-		child_width := c.child.Width()
-		child_height := c.child.Height()
-		child_h_margin := c.child.MarginLeft() + c.child.MarginRight()
-		child_v_margin := c.child.MarginTop() + c.child.MarginBtm()
-
-		total_child_width := child_width + child_h_margin
-		total_child_height := child_height + child_v_margin
-		// Non visible controls do not reserve space.
-		if !c.child.Visible() {
-			total_child_width = 0
-			total_child_height = 0
-		}
-
-		// Determine child start point
-		child_x := x0 + c.child.MarginLeft()
-		child_y := y0 + c.child.MarginTop()
-		switch c.dir {
-		// Center
-		case TopCenter, BottomCenter, Center:
-			child_x = child_x + (control_width-total_child_width)/2
-		// Right
-		case Right, RightTop, RightCenter, RightBottom, TopRight, BottomRight:
-			child_x = x1 - total_child_width + c.child.MarginLeft()
-		}
-		switch c.dir {
-		// Center
-		case LeftCenter, RightCenter, Center:
-			child_y = child_y + (control_height-total_child_height)/2
-		// Bottom
-		case Bottom, BottomLeft, BottomCenter, BottomRight, LeftBottom, RightBottom:
-			child_y = y1 - total_child_height + c.child.MarginTop()
-		}
-
-		// Move child
-		c.child.SetPos(child_x, child_y)
-
-		// case Top, Bottom,Left,Right,Fill:
-		// Resize child to fill space
-		switch c.dir {
-		case Top, Bottom:
-			c.child.SetSize(control_width-child_h_margin, child_height)
-		case Left, Right:
-			c.child.SetSize(child_width, control_height-child_v_margin)
-		case Fill:
-			c.child.SetSize(control_width-child_h_margin, control_height-child_v_margin)
-		}
-
-		// adjust available height and drawing corners
-		switch c.dir {
-		case Top, TopLeft, TopCenter, TopRight:
-			y0 += total_child_height
-
-		case Bottom, BottomLeft, BottomCenter, BottomRight:
-			y1 -= total_child_height
-
-		case Left, LeftTop, LeftCenter, LeftBottom:
-			x0 += total_child_width
-
-		case Right, RightTop, RightCenter, RightBottom:
-			x1 -= total_child_width
-		}
-		switch c.dir {
-		// Top, Bottom:
-		case Top, TopLeft, TopCenter, TopRight, Bottom, BottomLeft, BottomCenter, BottomRight:
-			control_height -= total_child_height
-
-		// Left,Right:
-		case Left, LeftTop, LeftCenter, LeftBottom, Right, RightTop, RightCenter, RightBottom:
-			control_width -= total_child_width
-		}
-	}
+	core.Dock(control.dockItems(),
+		control.padding_left, control.padding_top,
+		control.parent.ClientWidth()-control.padding_right, control.parent.ClientHeight()-control.padding_btm,
+		control.sizeToContent)
 }
 
 /* AutoPane
@@ -419,3 +398,11 @@ func NewGroupAutoPanel(parent Controller) *AutoPanel {
 
 	return &AutoPanel{panel, dock}
 }
+
+// PreferredSize fits the docked children.
+func (control *AutoPanel) PreferredSize() (width, height int) {
+	width, height = control.ContentSize()
+	border_width, border_height := control.Size()
+	client_width, client_height := control.ClientWidth(), control.ClientHeight()
+	return width + border_width - client_width, height + border_height - client_height
+}
@@ -0,0 +1,43 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// See core.LayoutNode.
+type LayoutNode = core.LayoutNode
+
+// See core.LayoutContainer.
+type LayoutContainer = core.LayoutContainer
+
+// See core.Constrained.
+type Constrained = core.Constrained
+
+// See core.SizeHinter.
+type SizeHinter = core.SizeHinter
+
+// See core.Alignment.
+type Alignment = core.Alignment
+
+const (
+	AlignStretch = core.AlignStretch
+	AlignStart   = core.AlignStart
+	AlignCenter  = core.AlignCenter
+	AlignEnd     = core.AlignEnd
+)
+
+// See core.LayoutBox.
+type LayoutBox = core.LayoutBox
+
+func NewLayoutBox(width, height int) *LayoutBox {
+	return core.NewLayoutBox(width, height)
+}
+
+// See core.LayoutArea.
+type LayoutArea = core.LayoutArea
+
+func NewLayoutArea(width, height int) *LayoutArea {
+	return core.NewLayoutArea(width, height)
+}
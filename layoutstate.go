@@ -0,0 +1,179 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// See core.LayoutStateVersion.
+const LayoutStateVersion = core.LayoutStateVersion
+
+// See core.NodeState.
+type NodeState = core.NodeState
+
+// See core.DockState.
+type DockState = core.DockState
+
+// NodeStater nodes save and restore state beyond their geometry.
+type NodeStater interface {
+	SaveNodeState(state *NodeState)
+	LoadNodeState(state *NodeState)
+}
+
+// See core.LayoutMigration.
+type LayoutMigration = core.LayoutMigration
+
+// See core.RegisterLayoutMigration.
+func RegisterLayoutMigration(from int, migrate LayoutMigration) {
+	core.RegisterLayoutMigration(from, migrate)
+}
+
+// See core.CtlState.
+type CtlState = core.CtlState
+
+// saveNode records geometry of node and whatever else it knows to save.
+func saveNode(node LayoutNode) *NodeState {
+	x, y := node.Pos()
+	state := &NodeState{X: x, Y: y, Width: node.Width(), Height: node.Height()}
+	if stater, ok := node.(NodeStater); ok {
+		stater.SaveNodeState(state)
+	}
+	return state
+}
+
+// loadNode restores geometry of node and whatever else it saved.
+func loadNode(node LayoutNode, state *NodeState) {
+	node.SetPos(state.X, state.Y)
+	node.SetSize(state.Width, state.Height)
+	if stater, ok := node.(NodeStater); ok {
+		stater.LoadNodeState(state)
+	}
+}
+
+// key of the control at index i.
+func (control *SimpleDock) key(i int) string {
+	if name := control.layoutCtl[i].name; name != "" {
+		return name
+	}
+	return core.IndexKey(i)
+}
+
+func (control *SimpleDock) saveControls() map[string]*NodeState {
+	controls := make(map[string]*NodeState, len(control.layoutCtl))
+	for i, c := range control.layoutCtl {
+		controls[control.key(i)] = saveNode(c.child)
+	}
+	return controls
+}
+
+// loadControls restores the controls found in the saved state; others keep their geometry.
+func (control *SimpleDock) loadControls(controls map[string]*NodeState) {
+	for i, c := range control.layoutCtl {
+		if state, ok := controls[control.key(i)]; ok && state != nil {
+			loadNode(c.child, state)
+		}
+	}
+}
+
+// SaveNodeState saves the docked controls of nested docks such as AutoPanel.
+func (control *SimpleDock) SaveNodeState(state *NodeState) {
+	state.Children = control.saveControls()
+}
+
+func (control *SimpleDock) LoadNodeState(state *NodeState) {
+	control.loadControls(state.Children)
+}
+
+func (sp *VResizer) SaveNodeState(state *NodeState) {
+	if sp.control1 != nil {
+		state.Split = sp.control1.Width()
+	}
+}
+
+func (sp *VResizer) LoadNodeState(state *NodeState) {
+	if sp.control1 != nil && state.Split > 0 {
+		sp.control1.SetSize(state.Split, sp.control1.Height())
+	}
+}
+
+func (sp *HResizer) SaveNodeState(state *NodeState) {
+	if sp.control1 != nil {
+		state.Split = sp.control1.Height()
+	}
+}
+
+func (sp *HResizer) LoadNodeState(state *NodeState) {
+	if sp.control1 != nil && state.Split > 0 {
+		sp.control1.SetSize(sp.control1.Width(), state.Split)
+	}
+}
+
+// SaveNodeState saves the current page and the pages, keyed by the name given to SetPageName.
+func (control *TabView) SaveNodeState(state *NodeState) {
+	state.Current = control.Current()
+	state.Children = map[string]*NodeState{}
+	for i := 0; i < control.panels.Count(); i++ {
+		if stater, ok := control.panels.Panel(i).(NodeStater); ok {
+			page := &NodeState{}
+			stater.SaveNodeState(page)
+			state.Children[control.pageKey(i)] = page
+		}
+	}
+}
+
+func (control *TabView) LoadNodeState(state *NodeState) {
+	for i := 0; i < control.panels.Count(); i++ {
+		page, ok := state.Children[control.pageKey(i)]
+		if stater, isStater := control.panels.Panel(i).(NodeStater); ok && isStater && page != nil {
+			stater.LoadNodeState(page)
+		}
+	}
+	if state.Current >= 0 && state.Current < control.panels.Count() {
+		control.SetCurrent(state.Current)
+	}
+}
+
+// pageKey of the page at index i. Pages without a name are keyed by their index.
+func (control *TabView) pageKey(i int) string {
+	if name := control.pageNames[control.panels.Panel(i)]; name != "" {
+		return name
+	}
+	return core.IndexKey(i)
+}
+
+// SetPageName names panel in saved layout state, so its state survives
+// pages being added, removed or renamed. Names must be unique within the tab view
+// and may not start with '#'.
+func (control *TabView) SetPageName(panel Pane, name string) error {
+	var used []string
+	for p, n := range control.pageNames {
+		if p != panel {
+			used = append(used, n)
+		}
+	}
+	if err := core.CheckStateName(name, used); err != nil {
+		return err
+	}
+	if control.pageNames == nil {
+		control.pageNames = map[Pane]string{}
+	}
+	control.pageNames[panel] = name
+	return nil
+}
+
+// SaveNodeState saves column widths.
+func (control *ListView) SaveNodeState(state *NodeState) {
+	state.Columns = make([]int, control.cols)
+	for col := range state.Columns {
+		state.Columns[col] = control.ColumnWidth(col)
+	}
+}
+
+func (control *ListView) LoadNodeState(state *NodeState) {
+	for col, width := range state.Columns {
+		if col < control.cols {
+			control.SetColumnWidth(col, width)
+		}
+	}
+}
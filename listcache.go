@@ -0,0 +1,27 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// ListDataSource supplies the items of a virtual ListView.
+type ListDataSource = core.ListDataSource
+
+// IndexedDataSource is a ListDataSource that finds the index of its items
+// itself, typically from a key kept with the item, so it is not scanned.
+type IndexedDataSource = core.IndexedDataSource
+
+// EditableDataSource is a data source a virtual ListView can insert items
+// into and delete items from.
+type EditableDataSource = core.EditableDataSource
+
+// ListItemCache keeps the range of items a virtual ListView is about to draw,
+// so the data source is asked for each item once per screen.
+type ListItemCache = core.ListItemCache
+
+// NewListItemCache caches at most maxSize items of source.
+func NewListItemCache(source ListDataSource, maxSize int) *ListItemCache {
+	return core.NewListItemCache(source, maxSize)
+}
@@ -0,0 +1,39 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// See core.ColumnComparator.
+type ColumnComparator = core.ColumnComparator
+
+// See core.SortLast.
+const SortLast = core.SortLast
+
+// See core.CompareText.
+func CompareText(a, b string) int {
+	return core.CompareText(a, b)
+}
+
+// See core.CompareNatural.
+func CompareNatural(a, b string) int {
+	return core.CompareNatural(a, b)
+}
+
+// See core.CompareNumeric.
+func CompareNumeric(a, b string) int {
+	return core.CompareNumeric(a, b)
+}
+
+// See core.CompareDate.
+func CompareDate(layout string) ColumnComparator {
+	return core.CompareDate(layout)
+}
+
+// See core.SortKey.
+type SortKey = core.SortKey
+
+// See core.ListSorter.
+type ListSorter = core.ListSorter
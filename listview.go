@@ -9,14 +9,12 @@ import (
 	"syscall"
 	"unsafe"
 
+	"github.com/samuel-jimenez/windigo/core"
 	"github.com/samuel-jimenez/windigo/w32"
 )
 
 // ListItem represents an item in a ListView widget.
-type ListItem interface {
-	Text() []string  // Text returns the text of the multi-column item.
-	ImageIndex() int // ImageIndex is used only if SetImageList is called on the listview
-}
+type ListItem = core.ListItem
 
 // ListItemChecker is used for checkbox support in ListView.
 type ListItemChecker interface {
@@ -54,8 +52,23 @@ type ListView struct {
 	item2Handle map[ListItem]uintptr
 	handle2Item map[uintptr]ListItem
 
+	cache *ListItemCache // virtual mode only
+
 	sort func(int, bool)
 
+	sorter  ListSorter
+	sorting bool // sort on header clicks
+
+	cellEditing  bool
+	readOnlyCols map[int]bool
+	cellChoices  map[int]cellChoices
+	editor       *cellEditor
+
+	noCopyShortcut bool
+
+	dragEnabled bool
+	drop        DropHandler
+
 	onEndLabelEdit,
 	onDoubleClick,
 	onClick,
@@ -65,14 +78,25 @@ type ListView struct {
 	onItemChanged,
 	onCheckChanged,
 	onViewChange,
-	onEndScroll EventManager
+	onEndScroll,
+	onCellEdited EventManager
 }
 
 func NewListView(parent Controller) *ListView {
+	return newListView(parent, 0)
+}
+
+// NewVirtualListView creates a ListView that does not store items.
+// Items are read from the data source set by SetDataSource as they are drawn.
+func NewVirtualListView(parent Controller) *ListView {
+	return newListView(parent, w32.LVS_OWNERDATA)
+}
+
+func newListView(parent Controller, style uint) *ListView {
 	control := new(ListView)
 
 	control.InitControl(w32.WC_LISTVIEW, parent /*w32.WS_EX_CLIENTEDGE*/, 0,
-		w32.WS_CHILD|w32.WS_VISIBLE|w32.WS_TABSTOP|w32.LVS_REPORT|w32.LVS_EDITLABELS|w32.LVS_SHOWSELALWAYS)
+		w32.WS_CHILD|w32.WS_VISIBLE|w32.WS_TABSTOP|w32.LVS_REPORT|w32.LVS_EDITLABELS|w32.LVS_SHOWSELALWAYS|style)
 
 	control.item2Handle = make(map[ListItem]uintptr)
 	control.handle2Item = make(map[uintptr]ListItem)
@@ -91,6 +115,43 @@ func NewListView(parent Controller) *ListView {
 	return control
 }
 
+// listViewCacheSize is the most items a virtual ListView keeps at once.
+const listViewCacheSize = 1000
+
+// IsVirtual reports whether the list view was created by NewVirtualListView.
+func (control *ListView) IsVirtual() bool {
+	return w32.GetWindowLong(control.hwnd, w32.GWL_STYLE)&w32.LVS_OWNERDATA != 0
+}
+
+// SetDataSource sets the items of a virtual list view. Sources that are an
+// IndexedDataSource or EditableDataSource support item lookup and editing.
+func (control *ListView) SetDataSource(source ListDataSource) {
+	if !control.IsVirtual() {
+		panic("SetDataSource needs a ListView made by NewVirtualListView")
+	}
+	control.cache = NewListItemCache(source, listViewCacheSize)
+	control.Refresh()
+}
+
+// DataSource of a virtual list view, nil otherwise.
+func (control *ListView) DataSource() ListDataSource {
+	if control.cache == nil {
+		return nil
+	}
+	return control.cache.Source()
+}
+
+// Refresh rereads the data source of a virtual list view after it changed.
+// Selection is kept by index.
+func (control *ListView) Refresh() {
+	if control.cache == nil {
+		return
+	}
+	control.cache.Invalidate()
+	w32.SendMessage(control.hwnd, w32.LVM_SETITEMCOUNT, uintptr(control.cache.Count()), w32.LVSICF_NOSCROLL)
+	control.Invalidate(true)
+}
+
 // FIXME: Changes the state of an item in a list-view control. Refer LVM_SETITEMSTATE message.
 func (control *ListView) setItemState(i int, state, mask uint) {
 	var item w32.LVITEM
@@ -113,6 +174,98 @@ func (control *ListView) EnableSortHeader(enable bool,
 	ToggleStyle(control.hwnd, !enable, w32.LVS_NOSORTHEADER)
 }
 
+// SortableDataSource is a ListDataSource that can reorder its items,
+// so a virtual ListView can sort.
+type SortableDataSource interface {
+	ListDataSource
+	// Sort must be stable.
+	Sort(compare func(a, b ListItem) int)
+}
+
+// EnableSorting sorts the list view when a column header is clicked.
+// Shift+click adds the column as a secondary sort key.
+// Virtual list views sort only if their data source is a SortableDataSource.
+func (control *ListView) EnableSorting(enable bool) {
+	control.sorting = enable
+	ToggleStyle(control.hwnd, !enable, w32.LVS_NOSORTHEADER)
+}
+
+// SetColumnComparator sets how column col is sorted, CompareText by default.
+func (control *ListView) SetColumnComparator(col int, compare ColumnComparator) {
+	control.sorter.SetComparator(col, compare)
+}
+
+// SortKeys returns the columns the list view is sorted by.
+func (control *ListView) SortKeys() []SortKey {
+	return control.sorter.Keys()
+}
+
+// SortBy sorts the list view by keys and shows their sort arrows.
+func (control *ListView) SortBy(keys ...SortKey) {
+	control.sorter.SetKeys(keys...)
+	control.sortItems()
+	control.setSortArrows(keys)
+}
+
+// gListSortRanks holds the rank of each item, by its lParam, while
+// LVM_SORTITEMS runs. Sorting happens on the UI thread only.
+var gListSortRanks map[uintptr]int
+
+var listSortCallback = syscall.NewCallback(func(lparam1, lparam2, lparamSort uintptr) uintptr {
+	return uintptr(gListSortRanks[lparam1] - gListSortRanks[lparam2])
+})
+
+func (control *ListView) sortItems() {
+	if control.cache != nil {
+		if source, ok := control.cache.Source().(SortableDataSource); ok {
+			source.Sort(control.sorter.Compare)
+			control.Refresh()
+		}
+		return
+	}
+
+	items := make([]ListItem, control.ItemCount())
+	for i := range items {
+		items[i] = control.findItemByIndex(i)
+		if items[i] == nil {
+			return
+		}
+	}
+	// The native sort is not stable, so it is handed the ranks of the stable sort.
+	// They are looked up by lParam, as the positions change while it sorts.
+	ranks := control.sorter.Ranks(items)
+	gListSortRanks = make(map[uintptr]int, len(items))
+	for i, item := range items {
+		gListSortRanks[control.item2Handle[item]] = ranks[i]
+	}
+	w32.SendMessage(control.hwnd, w32.LVM_SORTITEMS, 0, listSortCallback)
+	gListSortRanks = nil
+}
+
+// setSortArrows shows the sort direction of keys in the column headers.
+func (control *ListView) setSortArrows(keys []SortKey) {
+	header := w32.HWND(w32.SendMessage(control.hwnd, w32.LVM_GETHEADER, 0, 0))
+	if header == 0 {
+		return
+	}
+	for col := range control.cols {
+		item := w32.HDITEM{Mask: w32.HDI_FORMAT}
+		w32.SendMessage(header, w32.HDM_GETITEMW, uintptr(col), uintptr(unsafe.Pointer(&item)))
+		item.Fmt &^= w32.HDF_SORTUP | w32.HDF_SORTDOWN
+		for _, key := range keys {
+			if key.Column != col {
+				continue
+			}
+			if key.Descending {
+				item.Fmt |= w32.HDF_SORTDOWN
+			} else {
+				item.Fmt |= w32.HDF_SORTUP
+			}
+		}
+		w32.SendMessage(header, w32.HDM_SETITEMW, uintptr(col), uintptr(unsafe.Pointer(&item)))
+	}
+}
+
 func (control *ListView) EnableSortAscending(enable bool) {
 	ToggleStyle(control.hwnd, enable, w32.LVS_SORTASCENDING)
 }
@@ -184,6 +337,10 @@ func (control *ListView) SetColumnWidth(col, width int) {
 	}
 }
 
+func (control *ListView) ColumnWidth(col int) int {
+	return int(w32.SendMessage(control.hwnd, w32.LVM_GETCOLUMNWIDTH, uintptr(col), 0))
+}
+
 func (control *ListView) GetNumColumns() int {
 	return control.cols
 }
@@ -242,7 +399,34 @@ func (control *ListView) AddItem(item ListItem) {
 	control.InsertItem(item, control.ItemCount())
 }
 
+// TryAddItem is AddItem that reports why item could not be added.
+func (control *ListView) TryAddItem(item ListItem) error {
+	return control.TryInsertItem(item, control.ItemCount())
+}
+
+// ErrListViewVirtual is returned when a virtual ListView is asked to change
+// items its data source can not change, see EditableDataSource.
+var ErrListViewVirtual = errors.New("virtual ListView data source is not editable")
+
+// InsertItem adds item at index. Virtual list views insert into their data source.
 func (control *ListView) InsertItem(item ListItem, index int) {
+	control.TryInsertItem(item, index)
+}
+
+// TryInsertItem is InsertItem that reports why item could not be inserted,
+// such as ErrListViewVirtual.
+func (control *ListView) TryInsertItem(item ListItem, index int) error {
+	if control.cache != nil {
+		source, ok := control.cache.Source().(EditableDataSource)
+		if !ok {
+			return ErrListViewVirtual
+		}
+		if err := source.Insert(item, index); err != nil {
+			return err
+		}
+		control.Refresh()
+		return nil
+	}
 	text := item.Text()
 	li := &w32.LVITEM{
 		Mask:    w32.LVIF_TEXT | w32.LVIF_PARAM,
@@ -254,11 +438,14 @@ func (control *ListView) InsertItem(item ListItem, index int) {
 	ix := new(int)
 	*ix = control.lastIndex
 	li.LParam = uintptr(*ix)
-	control.handle2Item[li.LParam] = item
-	control.item2Handle[item] = li.LParam
 
 	control.applyImage(li, item.ImageIndex())
-	control.insertLvItem(li)
+	control.applyGroup(li, item)
+	if li.IItem = control.insertLvItem(li); li.IItem == -1 {
+		return errors.New("SendMessage(LVM_INSERTITEM) failed")
+	}
+	control.handle2Item[li.LParam] = item
+	control.item2Handle[item] = li.LParam
 
 	for i := 1; i < len(text); i++ {
 		li.Mask = w32.LVIF_TEXT
@@ -266,9 +453,21 @@ func (control *ListView) InsertItem(item ListItem, index int) {
 		li.ISubItem = int32(i)
 		control.setLvItem(li)
 	}
+	return nil
 }
 
+// UpdateItem redraws item after it changed. Virtual list views find it in the
+// data source if it is an IndexedDataSource, otherwise among the cached items.
 func (control *ListView) UpdateItem(item ListItem) bool {
+	if control.cache != nil {
+		index := control.cache.IndexOf(item)
+		if index == -1 {
+			return false
+		}
+		control.cache.Invalidate()
+		return w32.SendMessage(control.hwnd, w32.LVM_REDRAWITEMS, uintptr(index), uintptr(index)) != 0
+	}
+
 	lparam, ok := control.item2Handle[item]
 	if !ok {
 		return false
@@ -288,6 +487,7 @@ func (control *ListView) UpdateItem(item ListItem) bool {
 	}
 
 	control.applyImage(li, item.ImageIndex())
+	control.applyGroup(li, item)
 	control.setLvItem(li)
 
 	for i := 1; i < len(text); i++ {
@@ -303,8 +503,9 @@ func (control *ListView) insertLvColumn(controlColumn *w32.LVCOLUMN, iCol int) {
 	w32.SendMessage(control.hwnd, w32.LVM_INSERTCOLUMN, uintptr(iCol), uintptr(unsafe.Pointer(controlColumn)))
 }
 
-func (control *ListView) insertLvItem(controlItem *w32.LVITEM) {
-	w32.SendMessage(control.hwnd, w32.LVM_INSERTITEM, 0, uintptr(unsafe.Pointer(controlItem)))
+// insertLvItem returns the index of the new item, or -1.
+func (control *ListView) insertLvItem(controlItem *w32.LVITEM) int32 {
+	return int32(w32.SendMessage(control.hwnd, w32.LVM_INSERTITEM, 0, uintptr(unsafe.Pointer(controlItem))))
 }
 
 func (control *ListView) setLvItem(controlItem *w32.LVITEM) {
@@ -320,23 +521,56 @@ func (control *ListView) DeleteAllItems() bool {
 	return false
 }
 
+// DeleteItem removes item. Virtual list views delete it from their data source.
 func (control *ListView) DeleteItem(item ListItem) error {
 	index := control.findIndexByItem(item)
 	if index == -1 {
 		return errors.New("item not found")
 	}
 
-	if w32.SendMessage(control.hwnd, w32.LVM_DELETEITEM, uintptr(index), 0) == 0 {
-		return errors.New("SendMessage(TVM_DELETEITEM) failed")
+	if control.cache != nil {
+		source, ok := control.cache.Source().(EditableDataSource)
+		if !ok {
+			return ErrListViewVirtual
+		}
+		if err := source.Delete(index); err != nil {
+			return err
+		}
+		control.Refresh()
+		return nil
 	}
+	return control.deleteRow(control.item2Handle[item])
+}
 
-	h := control.item2Handle[item]
-	delete(control.item2Handle, item)
-	delete(control.handle2Item, h)
+// deleteRow deletes the row of lparam and forgets its item, unless the item
+// was inserted again in another row, as DragDrop.Move does.
+func (control *ListView) deleteRow(lparam uintptr) error {
+	it := &w32.LVFINDINFO{
+		Flags:  w32.LVFI_PARAM,
+		LParam: lparam,
+	}
+	var i int = -1
+	index := w32.SendMessage(control.hwnd, w32.LVM_FINDITEM, uintptr(i), uintptr(unsafe.Pointer(it)))
+	if int(index) == -1 {
+		return errors.New("item not found")
+	}
+	if w32.SendMessage(control.hwnd, w32.LVM_DELETEITEM, index, 0) == 0 {
+		return errors.New("SendMessage(LVM_DELETEITEM) failed")
+	}
+
+	item := control.handle2Item[lparam]
+	delete(control.handle2Item, lparam)
+	if control.item2Handle[item] == lparam {
+		delete(control.item2Handle, item)
+	}
 	return nil
 }
 
 func (control *ListView) findIndexByItem(item ListItem) int {
+	if control.cache != nil {
+		return control.cache.IndexOf(item)
+	}
+
 	lparam, ok := control.item2Handle[item]
 	if !ok {
 		return -1
@@ -351,6 +585,10 @@ func (control *ListView) findIndexByItem(item ListItem) int {
 }
 
 func (control *ListView) findItemByIndex(i int) ListItem {
+	if control.cache != nil {
+		return control.cache.Item(i)
+	}
+
 	it := &w32.LVITEM{
 		Mask:  w32.LVIF_PARAM,
 		IItem: int32(i),
@@ -404,6 +642,20 @@ func (control *ListView) SelectedItems() []ListItem {
 	return items
 }
 
+// SelectedIndices returns the indices of the selected items.
+func (control *ListView) SelectedIndices() []int {
+	var indices []int
+
+	var i int = -1
+	for {
+		if i = int(w32.SendMessage(control.hwnd, w32.LVM_GETNEXTITEM, uintptr(i), uintptr(w32.LVNI_SELECTED))); i == -1 {
+			break
+		}
+		indices = append(indices, i)
+	}
+	return indices
+}
+
 func (control *ListView) SelectedCount() uint {
 	return uint(w32.SendMessage(control.hwnd, w32.LVM_GETSELECTEDCOUNT, 0, 0))
 }
@@ -465,6 +717,34 @@ func (control *ListView) OnEndScroll() *EventManager {
 	return &control.onEndScroll
 }
 
+// dispItem is the item a LVN_GETDISPINFO request is for.
+func (control *ListView) dispItem(lvItem *w32.LVITEM) ListItem {
+	if control.cache != nil {
+		return control.cache.Item(int(lvItem.IItem))
+	}
+	return control.handle2Item[lvItem.LParam]
+}
+
+// virtualDispInfo answers LVN_GETDISPINFO from the data source.
+func (control *ListView) virtualDispInfo(lvItem *w32.LVITEM) {
+	item := control.cache.Item(int(lvItem.IItem))
+	if item == nil {
+		return
+	}
+	if lvItem.Mask&w32.LVIF_TEXT != 0 && lvItem.PszText != nil && lvItem.CchTextMax > 0 {
+		text := ""
+		if columns := item.Text(); int(lvItem.ISubItem) < len(columns) {
+			text = columns[lvItem.ISubItem]
+		}
+		buf := unsafe.Slice(lvItem.PszText, lvItem.CchTextMax)
+		n := copy(buf, syscall.StringToUTF16(text))
+		buf[min(n, len(buf))-1] = 0
+	}
+	if lvItem.Mask&w32.LVIF_IMAGE != 0 {
+		lvItem.IImage = int32(item.ImageIndex())
+	}
+}
+
 // Message processer
 func (control *ListView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	switch msg {
@@ -492,6 +772,19 @@ func (control *ListView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 					control.sortCol = sortCol
 				}
 				control.sort(control.sortCol, control.sortAscending)
+				control.setSortArrows([]SortKey{{Column: control.sortCol, Descending: !control.sortAscending}})
+			} else if control.sorting {
+				control.sorter.Click(int(nm.ISubItem), w32.GetKeyState(w32.VK_SHIFT) < 0)
+				control.sortItems()
+				control.setSortArrows(control.sorter.Keys())
+			}
+
+		case w32.LVN_BEGINDRAG:
+			nm := (*w32.NMLISTVIEW)(unsafe.Pointer(nm))
+			if items := control.SelectedItems(); len(items) > 0 && control.dragEnabled {
+				var origin w32.POINT
+				image := w32.HIMAGELIST(w32.SendMessage(control.hwnd, w32.LVM_CREATEDRAGIMAGE, uintptr(nm.IItem), uintptr(unsafe.Pointer(&origin))))
+				beginDrag(DragDrop{Source: control, ListItems: items}, image, int(nm.PtAction.X), int(nm.PtAction.Y))
 			}
 
 		case w32.LVN_BEGINLABELEDITW:
@@ -509,6 +802,9 @@ func (control *ListView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 		case w32.NM_DBLCLK:
 			ac := (*w32.NMITEMACTIVATE)(unsafe.Pointer(lparam))
 			control.onDoubleClick.Fire(NewEvent(control, ListViewEvent{int(ac.IItem), int(ac.ISubItem)}))
+			if control.cellEditing && ac.IItem >= 0 {
+				control.BeginCellEdit(int(ac.IItem), int(ac.ISubItem))
+			}
 
 		case w32.NM_RCLICK:
 			ac := (*w32.NMITEMACTIVATE)(unsafe.Pointer(lparam))
@@ -551,6 +847,14 @@ func (control *ListView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 					}
 				}
 			}
+			if nmkey.WVKey == 'C' && !control.noCopyShortcut && ModifiersDown() == ModControl {
+				control.CopySelection()
+			}
+			if nmkey.WVKey == w32.VK_F2 && control.cellEditing {
+				if row := int(w32.SendMessage(control.hwnd, w32.LVM_GETNEXTITEM, ^uintptr(0), w32.LVNI_FOCUSED)); row >= 0 {
+					control.beginCellEditAt(row, 0)
+				}
+			}
 			control.onKeyDown.Fire(NewEvent(control, nmkey.WVKey))
 			key := nmkey.WVKey
 			w32.SendMessage(control.Parent().Handle(), w32.WM_KEYDOWN, uintptr(key), 0)
@@ -569,8 +873,11 @@ func (control *ListView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 
 		case w32.LVN_GETDISPINFO:
 			nmdi := (*w32.NMLVDISPINFO)(unsafe.Pointer(lparam))
+			if control.cache != nil {
+				control.virtualDispInfo(&nmdi.Item)
+			}
 			if nmdi.Item.StateMask&w32.LVIS_STATEIMAGEMASK > 0 {
-				if item, ok := control.handle2Item[nmdi.Item.LParam]; ok {
+				if item := control.dispItem(&nmdi.Item); item != nil {
 					if item, ok := item.(ListItemChecker); ok {
 
 						checked := item.Checked()
@@ -585,6 +892,30 @@ func (control *ListView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 
 			control.onViewChange.Fire(NewEvent(control, nil))
 
+		case w32.LVN_ODCACHEHINT:
+			if control.cache != nil {
+				hint := (*w32.NMLVCACHEHINT)(unsafe.Pointer(nm))
+				control.cache.Hint(int(hint.IFrom), int(hint.ITo))
+			}
+
+		case w32.LVN_ODFINDITEMW:
+			if control.cache != nil {
+				find := (*w32.NMLVFINDITEM)(unsafe.Pointer(nm))
+				if find.Lvfi.Flags&(w32.LVFI_STRING|w32.LVFI_PARTIAL) == 0 || find.Lvfi.PszText == nil {
+					return ^uintptr(0)
+				}
+				text := w32.UTF16PtrToString(find.Lvfi.PszText)
+				index := control.cache.Find(text, int(find.IStart),
+					find.Lvfi.Flags&(w32.LVFI_PARTIAL|w32.LVFI_SUBSTRING) != 0, find.Lvfi.Flags&w32.LVFI_WRAP != 0)
+				return uintptr(index)
+			}
+
+		case w32.NM_CUSTOMDRAW:
+			return control.customDraw((*w32.NMLVCUSTOMDRAW)(unsafe.Pointer(nm)))
+
+		case w32.LVN_BEGINSCROLL:
+			control.EndCellEdit(true)
+
 		case w32.LVN_ENDSCROLL:
 			control.onEndScroll.Fire(NewEvent(control, nil))
 		}
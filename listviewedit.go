@@ -0,0 +1,229 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"errors"
+	"slices"
+	"unsafe"
+
+	"github.com/samuel-jimenez/windigo/core"
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// cellEditor is the Edit or ComboBox laid over a ListView cell while it is edited.
+// It is registered in place of the control, to see its keys first.
+type cellEditor struct {
+	Controller
+
+	list     *ListView
+	row, col int
+	item     ListItem
+	old      string
+
+	dropped func() bool // ComboBox drop-down is open
+	close   func()
+}
+
+func (editor *cellEditor) PreTranslateMessage(msg *w32.MSG) bool {
+	if msg.Message == w32.WM_KEYDOWN && (editor.dropped == nil || !editor.dropped()) {
+		switch msg.WParam {
+		case w32.VK_RETURN:
+			editor.list.EndCellEdit(true)
+			editor.list.SetFocus()
+			return true
+		case w32.VK_ESCAPE:
+			editor.list.EndCellEdit(false)
+			editor.list.SetFocus()
+			return true
+		}
+	}
+	return editor.Controller.PreTranslateMessage(msg)
+}
+
+// cellChoices are the items of a column edited with a ComboBox.
+type cellChoices struct {
+	items    []string
+	editable bool
+}
+
+// EnableCellEditing lets the user edit any cell by double clicking it,
+// or the first editable cell of the focused row by pressing F2.
+// It replaces the native label edit of column 0.
+func (control *ListView) EnableCellEditing(enable bool) {
+	control.cellEditing = enable
+	if enable {
+		control.EnableEditLabels(false)
+	} else {
+		control.EndCellEdit(false)
+	}
+}
+
+// SetColumnReadOnly stops cells of column col from being edited.
+func (control *ListView) SetColumnReadOnly(col int, readOnly bool) {
+	if control.readOnlyCols == nil {
+		control.readOnlyCols = map[int]bool{}
+	}
+	control.readOnlyCols[col] = readOnly
+}
+
+// SetColumnChoices edits column col with a ComboBox of items instead of an Edit.
+// Unless editable, only the items can be chosen. Nil items restores the Edit.
+func (control *ListView) SetColumnChoices(col int, items []string, editable bool) {
+	if control.cellChoices == nil {
+		control.cellChoices = map[int]cellChoices{}
+	}
+	if items == nil {
+		delete(control.cellChoices, col)
+	} else {
+		control.cellChoices[col] = cellChoices{slices.Clone(items), editable}
+	}
+}
+
+// IsEditingCell reports whether a cell editor is open.
+func (control *ListView) IsEditingCell() bool {
+	return control.editor != nil
+}
+
+// BeginCellEdit opens an editor over the cell at row and col.
+// Enter or moving the focus away commits the edit, Escape cancels it.
+func (control *ListView) BeginCellEdit(row, col int) error {
+	if col < 0 || col >= control.cols || control.readOnlyCols[col] {
+		return errors.New("column cannot be edited")
+	}
+	control.EndCellEdit(true)
+
+	item := control.findItemByIndex(row)
+	if item == nil {
+		return errors.New("item not found")
+	}
+	w32.SendMessage(control.hwnd, w32.LVM_ENSUREVISIBLE, uintptr(row), w32.FALSE)
+
+	// Column 0 bounds span the whole row, its label is the cell.
+	rect := w32.RECT{Top: int32(col), Left: w32.LVIR_BOUNDS}
+	if col == 0 {
+		rect.Left = w32.LVIR_LABEL
+	}
+	if w32.SendMessage(control.hwnd, w32.LVM_GETSUBITEMRECT, uintptr(row), uintptr(unsafe.Pointer(&rect))) == 0 {
+		return errors.New("SendMessage(LVM_GETSUBITEMRECT) failed")
+	}
+
+	// The editor is a sibling of the list view, so its parent gets its notifications.
+	parent := control.Parent()
+	x, y := w32.ClientToScreen(control.hwnd, int(rect.Left), int(rect.Top))
+	x, y, _ = w32.ScreenToClient(parent.Handle(), x, y)
+	width, height := int(rect.Right-rect.Left), int(rect.Bottom-rect.Top)
+
+	editor := &cellEditor{list: control, row: row, col: col, item: item, old: core.Cell(item.Text(), col)}
+	if choices, ok := control.cellChoices[col]; ok {
+		var combo *ComboBox
+		if choices.editable {
+			combo = NewComboBox(parent)
+		} else {
+			combo = NewListComboBox(parent)
+		}
+		for _, choice := range choices.items {
+			combo.AddItem(choice)
+		}
+		if i := slices.Index(choices.items, editor.old); i >= 0 {
+			combo.SetSelectedItem(i)
+		} else if choices.editable {
+			combo.SetText(editor.old)
+		}
+		combo.SetFont(control.Font())
+		combo.SetPos(x, y)
+		combo.SetSize(width, 200)
+
+		editor.Controller = combo
+		editor.dropped = func() bool {
+			return w32.SendMessage(combo.hwnd, w32.CB_GETDROPPEDSTATE, 0, 0) != 0
+		}
+		editor.close = func() { closeComboBox(combo) }
+		if combo.Edit != nil {
+			// Keys typed in the edit field then reach the editor, registered for the combo box.
+			UnRegMsgHandler(combo.Edit.Handle())
+		}
+		combo.OnKillFocus().Subscribe(func(*Event) { control.endCellEditor(editor) })
+	} else {
+		edit := NewEdit(parent)
+		edit.SetText(editor.old)
+		edit.SetFont(control.Font())
+		edit.SetPos(x, y)
+		edit.SetSize(width, height)
+		edit.SelectText(0, -1)
+
+		editor.Controller = edit
+		editor.close = edit.Close
+		edit.OnKillFocus().Subscribe(func(*Event) { control.endCellEditor(editor) })
+	}
+	RegMsgHandler(editor)
+
+	// Keep the list view from painting over the editor.
+	ToggleStyle(control.hwnd, true, w32.WS_CLIPSIBLINGS)
+	w32.SetWindowPos(editor.Handle(), w32.HWND_TOP, 0, 0, 0, 0, w32.SWP_NOMOVE|w32.SWP_NOSIZE)
+
+	control.editor = editor
+	editor.SetFocus()
+	return nil
+}
+
+// endCellEditor commits editor if it is still open, once the message that
+// lost it the focus is done: it must not be destroyed in its own notification.
+func (control *ListView) endCellEditor(editor *cellEditor) {
+	BeginInvoke(func() {
+		if control.editor == editor {
+			control.EndCellEdit(true)
+		}
+	})
+}
+
+// EndCellEdit closes the cell editor, firing OnCellEdited if commit and the text changed.
+func (control *ListView) EndCellEdit(commit bool) {
+	editor := control.editor
+	if editor == nil {
+		return
+	}
+	control.editor = nil
+	text := editor.Text()
+	editor.close()
+
+	if commit && text != editor.old {
+		control.onCellEdited.Fire(NewEvent(control, &CellEditEventData{
+			ListViewEvent: ListViewEvent{editor.row, editor.col},
+			Item:          editor.item,
+			OldText:       editor.old,
+			NewText:       text,
+		}))
+		control.UpdateItem(editor.item)
+	}
+}
+
+// beginCellEditAt edits the first editable column of row, starting at col.
+func (control *ListView) beginCellEditAt(row, col int) {
+	for ; col < control.cols; col++ {
+		if !control.readOnlyCols[col] {
+			control.BeginCellEdit(row, col)
+			return
+		}
+	}
+}
+
+// OnCellEdited fires when a cell edit is committed with new text.
+// Event.Data is a *CellEditEventData. Handlers store the new text in the item,
+// which is then redrawn.
+func (control *ListView) OnCellEdited() *EventManager {
+	return &control.onCellEdited
+}
+
+// closeComboBox closes combo along with the child windows NewComboBox registered.
+func closeComboBox(combo *ComboBox) {
+	var cbInfo w32.COMBOBOXINFO
+	cbInfo.Size = w32.DWORD(unsafe.Sizeof(cbInfo))
+	if w32.SendMessage(combo.hwnd, w32.CB_GETCOMBOBOXINFO, 0, uintptr(unsafe.Pointer(&cbInfo))) != 0 {
+		UnRegMsgHandler(cbInfo.ListHandle)
+		UnRegMsgHandler(cbInfo.EditHandle)
+	}
+	combo.Close()
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"io"
+	"strings"
+	"unsafe"
+
+	"github.com/samuel-jimenez/windigo/core"
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// ColumnCaptions returns the captions of the columns.
+func (control *ListView) ColumnCaptions() []string {
+	captions := make([]string, control.cols)
+	buf := make([]uint16, 256)
+	for i := range captions {
+		column := w32.LVCOLUMN{Mask: w32.LVCF_TEXT, PszText: &buf[0], CchTextMax: int32(len(buf))}
+		if w32.SendMessage(control.hwnd, w32.LVM_GETCOLUMN, uintptr(i), uintptr(unsafe.Pointer(&column))) != 0 {
+			captions[i] = w32.UTF16PtrToString(column.PszText)
+		}
+	}
+	return captions
+}
+
+// rows returns the text of all or the selected items, cut or padded to the columns.
+func (control *ListView) rows(selectedOnly bool) [][]string {
+	var indices []int
+	if selectedOnly {
+		indices = control.SelectedIndices()
+	} else {
+		indices = make([]int, control.ItemCount())
+		for i := range indices {
+			indices[i] = i
+		}
+	}
+
+	rows := make([][]string, 0, len(indices))
+	for _, i := range indices {
+		if item := control.findItemByIndex(i); item != nil {
+			text := item.Text()
+			row := make([]string, control.cols)
+			for col := range row {
+				row[col] = core.Cell(text, col)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// ExportCSV writes the column captions and all or the selected items as CSV.
+func (control *ListView) ExportCSV(w io.Writer, selectedOnly bool) error {
+	return WriteCSV(w, control.ColumnCaptions(), control.rows(selectedOnly))
+}
+
+// ExportTSV writes the column captions and all or the selected items as tab separated values.
+func (control *ListView) ExportTSV(w io.Writer, selectedOnly bool) error {
+	return WriteTSV(w, control.ColumnCaptions(), control.rows(selectedOnly))
+}
+
+// ExportHTML writes the column captions and all or the selected items as an HTML table.
+func (control *ListView) ExportHTML(w io.Writer, selectedOnly bool) error {
+	return WriteHTMLTable(w, control.ColumnCaptions(), control.rows(selectedOnly))
+}
+
+// CopySelection puts the selected items, without the column captions, on the clipboard
+// as text and as an HTML table, so they paste into editors and spreadsheets.
+// Ctrl+C calls it, see EnableCopyShortcut.
+func (control *ListView) CopySelection() error {
+	rows := control.rows(true)
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var text, table strings.Builder
+	if err := WriteTSV(&text, nil, rows); err != nil {
+		return err
+	}
+	if err := WriteHTMLTable(&table, nil, rows); err != nil {
+		return err
+	}
+	return NewClipboard(control).Write(
+		TextData(text.String()),
+		ClipboardData{ClipboardHTML, HTMLClipboardData(table.String())},
+	)
+}
+
+// EnableCopyShortcut turns the Ctrl+C handler on or off. It is on by default.
+func (control *ListView) EnableCopyShortcut(enable bool) {
+	control.noCopyShortcut = !enable
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// ListItemGrouper puts a ListItem in a group, see ListView.AddGroup.
+type ListItemGrouper interface {
+	GroupID() int
+}
+
+// ListItemStyler is used to color ListView rows and cells and change their fonts.
+type ListItemStyler interface {
+	// RowColor returns the text and background colors of the row, unless ok is false.
+	RowColor() (fg, bg Color, ok bool)
+	// CellColor returns the colors of column col, over the row colors, unless ok is false.
+	CellColor(col int) (fg, bg Color, ok bool)
+	// CellFont returns the font of column col, or nil for the list view font.
+	CellFont(col int) *Font
+}
+
+// EnableGroupView shows the items in the groups added by AddGroup.
+// Virtual list views cannot be grouped.
+func (control *ListView) EnableGroupView(enable bool) {
+	w32.SendMessage(control.hwnd, w32.LVM_ENABLEGROUPVIEW, uintptr(w32.BoolToBOOL(enable)), 0)
+}
+
+// AddGroup adds a group with the header text. Items whose GroupID is id are shown in it.
+// The user can collapse a collapsible group by clicking its header.
+func (control *ListView) AddGroup(id int, header string, collapsible bool) bool {
+	group := w32.LVGROUP{
+		Mask:      w32.LVGF_HEADER | w32.LVGF_GROUPID | w32.LVGF_STATE | w32.LVGF_ALIGN,
+		PszHeader: syscall.StringToUTF16Ptr(header),
+		IGroupId:  int32(id),
+		UAlign:    w32.LVGA_HEADER_LEFT,
+	}
+	group.CbSize = uint32(unsafe.Sizeof(group))
+	if collapsible {
+		group.StateMask, group.State = w32.LVGS_COLLAPSIBLE, w32.LVGS_COLLAPSIBLE
+	}
+	return int(w32.SendMessage(control.hwnd, w32.LVM_INSERTGROUP, ^uintptr(0), uintptr(unsafe.Pointer(&group)))) != -1
+}
+
+// SetGroupHeader changes the header text of group id.
+func (control *ListView) SetGroupHeader(id int, header string) bool {
+	group := w32.LVGROUP{Mask: w32.LVGF_HEADER, PszHeader: syscall.StringToUTF16Ptr(header)}
+	group.CbSize = uint32(unsafe.Sizeof(group))
+	return int(w32.SendMessage(control.hwnd, w32.LVM_SETGROUPINFO, uintptr(id), uintptr(unsafe.Pointer(&group)))) != -1
+}
+
+func (control *ListView) HasGroup(id int) bool {
+	return w32.SendMessage(control.hwnd, w32.LVM_HASGROUP, uintptr(id), 0) != 0
+}
+
+// RemoveGroup removes group id. Its items are hidden until moved to another group.
+func (control *ListView) RemoveGroup(id int) {
+	w32.SendMessage(control.hwnd, w32.LVM_REMOVEGROUP, uintptr(id), 0)
+}
+
+func (control *ListView) RemoveAllGroups() {
+	w32.SendMessage(control.hwnd, w32.LVM_REMOVEALLGROUPS, 0, 0)
+}
+
+// GroupCollapsed reports whether group id is collapsed.
+func (control *ListView) GroupCollapsed(id int) bool {
+	return w32.SendMessage(control.hwnd, w32.LVM_GETGROUPSTATE, uintptr(id), w32.LVGS_COLLAPSED) != 0
+}
+
+// SetGroupCollapsed collapses or expands group id.
+func (control *ListView) SetGroupCollapsed(id int, collapsed bool) bool {
+	group := w32.LVGROUP{Mask: w32.LVGF_STATE, StateMask: w32.LVGS_COLLAPSED}
+	group.CbSize = uint32(unsafe.Sizeof(group))
+	if collapsed {
+		group.State = w32.LVGS_COLLAPSED
+	}
+	return int(w32.SendMessage(control.hwnd, w32.LVM_SETGROUPINFO, uintptr(id), uintptr(unsafe.Pointer(&group)))) != -1
+}
+
+// applyGroup sets the group of lvItem if item is a ListItemGrouper.
+func (control *ListView) applyGroup(lvItem *w32.LVITEM, item ListItem) {
+	if grouper, ok := item.(ListItemGrouper); ok {
+		lvItem.Mask |= w32.LVIF_GROUPID
+		lvItem.IGroupId = int32(grouper.GroupID())
+	}
+}
+
+// customDraw colors the items that are ListItemStylers.
+func (control *ListView) customDraw(draw *w32.NMLVCUSTOMDRAW) uintptr {
+	switch draw.Nmcd.DwDrawStage {
+	case w32.CDDS_PREPAINT:
+		return w32.CDRF_NOTIFYITEMDRAW
+
+	case w32.CDDS_ITEMPREPAINT:
+		if styler := control.drawItem(draw); styler != nil {
+			control.styleCell(draw, styler, -1)
+			return w32.CDRF_NOTIFYSUBITEMDRAW | w32.CDRF_NEWFONT
+		}
+
+	case w32.CDDS_ITEMPREPAINT | w32.CDDS_SUBITEM:
+		if styler := control.drawItem(draw); styler != nil {
+			control.styleCell(draw, styler, int(draw.ISubItem))
+			return w32.CDRF_NEWFONT
+		}
+	}
+	return w32.CDRF_DODEFAULT
+}
+
+func (control *ListView) drawItem(draw *w32.NMLVCUSTOMDRAW) ListItemStyler {
+	var item ListItem
+	if control.cache != nil {
+		item = control.cache.Item(int(draw.Nmcd.DwItemSpec))
+	} else {
+		item = control.handle2Item[draw.Nmcd.LItemlParam]
+	}
+	styler, _ := item.(ListItemStyler)
+	return styler
+}
+
+// styleCell sets the colors and font of column col, or of the row if col is -1.
+// Every cell is set, as the colors of the previous cell carry over.
+func (control *ListView) styleCell(draw *w32.NMLVCUSTOMDRAW, styler ListItemStyler, col int) {
+	draw.ClrText, draw.ClrTextBk = w32.CLR_DEFAULT, w32.CLR_DEFAULT
+	if fg, bg, ok := styler.RowColor(); ok {
+		draw.ClrText, draw.ClrTextBk = w32.COLORREF(fg), w32.COLORREF(bg)
+	}
+	if col < 0 {
+		return
+	}
+	if fg, bg, ok := styler.CellColor(col); ok {
+		draw.ClrText, draw.ClrTextBk = w32.COLORREF(fg), w32.COLORREF(bg)
+	}
+	font := styler.CellFont(col)
+	if font == nil {
+		font = control.Font()
+	}
+	if font != nil {
+		w32.SelectObject(draw.Nmcd.Hdc, w32.HGDIOBJ(font.GetHFONT()))
+	}
+}
@@ -6,6 +6,8 @@ package windigo
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"syscall"
 	"unsafe"
 
@@ -13,26 +15,42 @@ import (
 )
 
 var (
-	nextMenuItemID  uint16 = 3
-	actionsByID            = make(map[uint16]*MenuItem)
-	shortcut2Action        = make(map[Shortcut]*MenuItem)
-	menuItems              = make(map[w32.HMENU][]*MenuItem)
-	radioGroups            = make(map[*MenuItem]*RadioGroup)
-	initialised     bool
+	nextMenuItemID uint16 = 3
+	actionsByID           = make(map[uint16]*MenuItem)
+	menuItems             = make(map[w32.HMENU][]*MenuItem)
+	radioGroups           = make(map[*MenuItem]*RadioGroup)
+	initialised    bool
 )
 
 var NoShortcut = Shortcut{}
 
+var contextMenuCommands = NewCommands()
+
+// The form and cursor position of the key that runs a shortcut, for the events of menu items.
+var (
+	shortcutForm  *Form
+	shortcutPoint w32.POINT
+)
+
+// ContextMenuCommands returns the commands of the context menu items with a shortcut,
+// which every form runs after its own commands. Menu items of a form are in Form.Commands.
+func ContextMenuCommands() *Commands {
+	return contextMenuCommands
+}
+
 // Menu for main window and context menus on controls.
 // Most methods used for both main window menu and context menu.
 type Menu struct {
 	hMenu w32.HMENU
 	hwnd  w32.HWND // root window. hwnd might be nil if it is context menu.
+	form  *Form
 }
 
 type MenuItem struct {
 	hMenu    w32.HMENU
 	hSubMenu w32.HMENU // Non zero if this item is in itself a submenu.
+	form     *Form     // nil in context menus
+	path     string    // texts of the submenus and the item, the command ID of its shortcut
 
 	text     string
 	toolTip  string
@@ -46,6 +64,9 @@ type MenuItem struct {
 
 	id uint16
 
+	// command items run a Command, which handles their shortcut.
+	command bool
+
 	onClick EventManager
 }
 
@@ -92,7 +113,6 @@ func initMenuItemInfoFromAction(item_info *w32.MENUITEMINFO, menu_item *MenuItem
 		var text string
 		if s := menu_item.shortcut; s.Key != 0 {
 			text = fmt.Sprintf("%s\t%s", menu_item.text, s.String())
-			shortcut2Action[menu_item.shortcut] = menu_item
 		} else {
 			text = menu_item.text
 		}
@@ -148,7 +168,7 @@ func (control *Menu) AddSubMenu(text string) *MenuItem {
 	if hSubMenu == 0 {
 		panic("failed CreateMenu")
 	}
-	return addMenuItem(control.hMenu, hSubMenu, text, Shortcut{}, nil, false)
+	return addMenuItem(control.form, "", control.hMenu, hSubMenu, text, Shortcut{}, nil, false)
 }
 
 // AddSubMenu_Shortcut returns item with a shortcut that is used as submenu to perform AddItem(s).
@@ -157,7 +177,7 @@ func (control *Menu) AddSubMenu_Shortcut(text string, shortcut Shortcut) *MenuIt
 	if hSubMenu == 0 {
 		panic("failed CreateMenu")
 	}
-	return addMenuItem(control.hMenu, hSubMenu, text, shortcut, nil, false)
+	return addMenuItem(control.form, "", control.hMenu, hSubMenu, text, shortcut, nil, false)
 }
 
 // This method will iterate through the menu items, group radio items together, build a
@@ -226,29 +246,29 @@ func (control *MenuItem) OnClick() *EventManager {
 }
 
 func (control *MenuItem) AddSeparator() {
-	addMenuItem(control.hSubMenu, 0, "-", Shortcut{}, nil, false)
+	addMenuItem(control.form, control.path, control.hSubMenu, 0, "-", Shortcut{}, nil, false)
 }
 
 // AddItem adds plain menu item.
 func (control *MenuItem) AddItem(text string, shortcut Shortcut) *MenuItem {
-	return addMenuItem(control.hSubMenu, 0, text, shortcut, nil, false)
+	return addMenuItem(control.form, control.path, control.hSubMenu, 0, text, shortcut, nil, false)
 }
 
 // AddItemCheckable adds plain menu item that can have a checkmark.
 func (control *MenuItem) AddItemCheckable(text string, shortcut Shortcut) *MenuItem {
-	return addMenuItem(control.hSubMenu, 0, text, shortcut, nil, true)
+	return addMenuItem(control.form, control.path, control.hSubMenu, 0, text, shortcut, nil, true)
 }
 
 // AddItemRadio adds plain menu item that can have a checkmark and is part of a radio group.
 func (control *MenuItem) AddItemRadio(text string, shortcut Shortcut) *MenuItem {
-	menuItem := addMenuItem(control.hSubMenu, 0, text, shortcut, nil, true)
+	menuItem := addMenuItem(control.form, control.path, control.hSubMenu, 0, text, shortcut, nil, true)
 	menuItem.isRadio = true
 	return menuItem
 }
 
 // AddItemWithBitmap adds menu item with shortcut and bitmap.
 func (control *MenuItem) AddItemWithBitmap(text string, shortcut Shortcut, image *Bitmap) *MenuItem {
-	return addMenuItem(control.hSubMenu, 0, text, shortcut, image, false)
+	return addMenuItem(control.form, control.path, control.hSubMenu, 0, text, shortcut, image, false)
 }
 
 // AddSubMenu adds a submenu.
@@ -257,7 +277,7 @@ func (control *MenuItem) AddSubMenu(text string) *MenuItem {
 	if hSubMenu == 0 {
 		panic("failed CreatePopupMenu")
 	}
-	return addMenuItem(control.hSubMenu, hSubMenu, text, Shortcut{}, nil, false)
+	return addMenuItem(control.form, control.path, control.hSubMenu, hSubMenu, text, Shortcut{}, nil, false)
 }
 
 // AddSubMenu_Shortcut adds a submenu with a shortcut.
@@ -266,14 +286,19 @@ func (control *MenuItem) AddSubMenu_Shortcut(text string, shortcut Shortcut) *Me
 	if hSubMenu == 0 {
 		panic("failed CreatePopupMenu")
 	}
-	return addMenuItem(control.hSubMenu, hSubMenu, text, shortcut, nil, false)
+	return addMenuItem(control.form, control.path, control.hSubMenu, hSubMenu, text, shortcut, nil, false)
 }
 
 // AddItem to the menu, set text to "-" for separators.
-func addMenuItem(hMenu, hSubMenu w32.HMENU, text string, shortcut Shortcut, image *Bitmap, checkable bool) *MenuItem {
+func addMenuItem(form *Form, path string, hMenu, hSubMenu w32.HMENU, text string, shortcut Shortcut, image *Bitmap, checkable bool) *MenuItem {
+	if path != "" {
+		path += "/"
+	}
 	item := &MenuItem{
 		hMenu:     hMenu,
 		hSubMenu:  hSubMenu,
+		form:      form,
+		path:      path + strings.ReplaceAll(text, "&", ""),
 		text:      text,
 		shortcut:  shortcut,
 		image:     image,
@@ -294,9 +319,47 @@ func addMenuItem(hMenu, hSubMenu w32.HMENU, text string, shortcut Shortcut, imag
 	if !w32.InsertMenuItem(hMenu, uint32(index), true, &item_info) {
 		panic("InsertMenuItem failed")
 	}
+	if shortcut.Key != 0 && !item.IsSeparator() {
+		item.registerShortcut()
+	}
 	return item
 }
 
+// registerShortcut makes the shortcut of item a command of its form, or of
+// ContextMenuCommands, so it can be listed and rebound. Its ID is "menu:" and its path.
+func (control *MenuItem) registerShortcut() {
+	commands := contextMenuCommands
+	if control.form != nil {
+		commands = control.form.commands
+	}
+	id := "menu:" + control.path
+	if commands.Command(id) != nil {
+		id += "#" + strconv.Itoa(int(control.id))
+	}
+	cmd, _ := commands.Register(Command{
+		ID:       id,
+		Title:    control.path,
+		Shortcut: control.shortcut,
+		Enabled:  control.Enabled,
+		Run:      control.runShortcut,
+	})
+	control.command = true
+	commands.menuItems[id] = append(commands.menuItems[id], control)
+	commands.updateMenuItem(control, cmd)
+	if control.form != nil {
+		control.form.accelerators = append(control.form.accelerators, id)
+	}
+}
+
+// runShortcut clicks the item for the form the shortcut was pressed in.
+func (control *MenuItem) runShortcut() {
+	form := control.form
+	if form == nil {
+		form = shortcutForm
+	}
+	control.onClick.Fire(NewEvent(form, shortcutPoint))
+}
+
 func indexInObserver(menu_item *MenuItem) int {
 	var idx int
 	for _, control := range menuItems[menu_item.hMenu] {
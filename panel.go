@@ -205,6 +205,9 @@ func NewMultiPanel(parent Controller) *MultiPanel {
 
 func (control *MultiPanel) Count() int { return len(control.panels) }
 
+// Panel at index.
+func (control *MultiPanel) Panel(index int) Pane { return control.panels[index] }
+
 // AddPanel adds panels to the internal list, first panel is visible all others are hidden.
 func (control *MultiPanel) AddPanel(panel Pane) {
 	if len(control.panels) > 0 {
@@ -0,0 +1,20 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// ParseKey returns the key named name, as written by Key.String or an alias such as Esc, Del or PgUp.
+// Names are case insensitive.
+func ParseKey(name string) (Key, error) {
+	return core.ParseKey(name)
+}
+
+// ParseShortcut parses a shortcut as written by Shortcut.String, like "Ctrl+Alt+F5".
+// Modifiers may be in any order and Win is a modifier. Key names are as for ParseKey,
+// "Ctrl++" is Ctrl with the plus key. An empty string is NoShortcut.
+func ParseShortcut(text string) (Shortcut, error) {
+	return core.ParseShortcut(text)
+}
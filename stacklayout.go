@@ -0,0 +1,24 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// See core.Orientation.
+type Orientation = core.Orientation
+
+const (
+	Horizontal = core.Horizontal
+	Vertical   = core.Vertical
+)
+
+// See core.StackLayout.
+type StackLayout = core.StackLayout
+
+func NewStackLayout(parent DockAllow, orientation Orientation) *StackLayout {
+	stack := core.NewStackLayout(parent, orientation)
+	parent.SetLayout(stack)
+	return stack
+}
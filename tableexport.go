@@ -0,0 +1,33 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"io"
+
+	"github.com/samuel-jimenez/windigo/core"
+)
+
+// WriteCSV writes header, unless nil, and rows as RFC 4180 CSV with CRLF line endings.
+func WriteCSV(w io.Writer, header []string, rows [][]string) error {
+	return core.WriteCSV(w, header, rows)
+}
+
+// WriteTSV writes header, unless nil, and rows as tab separated values with CRLF line endings,
+// the text spreadsheets expect on the clipboard. Tabs and line breaks in cells become spaces.
+func WriteTSV(w io.Writer, header []string, rows [][]string) error {
+	return core.WriteTSV(w, header, rows)
+}
+
+// WriteHTMLTable writes header, unless nil, and rows as an HTML table.
+func WriteHTMLTable(w io.Writer, header []string, rows [][]string) error {
+	return core.WriteHTMLTable(w, header, rows)
+}
+
+// HTMLClipboardData wraps an HTML fragment in the header of the
+// "HTML Format" clipboard format, which gives the byte offsets of the document and fragment.
+func HTMLClipboardData(fragment string) []byte {
+	return core.HTMLClipboardData(fragment)
+}
@@ -16,6 +16,7 @@ type TabView struct {
 	ControlBase
 
 	panels           *MultiPanel
+	pageNames        map[Pane]string // see SetPageName
 	onSelectedChange EventManager
 }
 
@@ -80,6 +81,7 @@ func (control *TabView) AddAutoPanel(text string) *AutoPanel {
 }
 
 func (control *TabView) DeletePanel(index int) {
+	delete(control.pageNames, control.panels.Panel(index))
 	w32.SendMessage(control.hwnd, w32.TCM_DELETEITEM, uintptr(index), 0)
 	control.panels.DeletePanel(index)
 	switch {
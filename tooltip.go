@@ -38,6 +38,18 @@ func (tp *ToolTip) SetTip(tool Controller, tip string) bool {
 	return w32.SendMessage(tp.Handle(), w32.TTM_ADDTOOL, 0, uintptr(unsafe.Pointer(&ti))) != w32.FALSE
 }
 
+// RemoveTip removes the tip set on tool.
+func (tp *ToolTip) RemoveTip(tool Controller) {
+	var ti w32.TOOLINFO
+	ti.CbSize = uint32(unsafe.Sizeof(ti))
+	if tool.Parent() != nil {
+		ti.Hwnd = tool.Parent().Handle()
+	}
+	ti.UId = uintptr(tool.Handle())
+
+	w32.SendMessage(tp.Handle(), w32.TTM_DELTOOL, 0, uintptr(unsafe.Pointer(&ti)))
+}
+
 func (tp *ToolTip) WndProc(msg uint, wparam, lparam uintptr) uintptr {
 	return w32.DefWindowProc(tp.hwnd, uint32(msg), wparam, lparam)
 }
@@ -0,0 +1,24 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// CheckState of a tree item.
+type CheckState = core.CheckState
+
+const (
+	Unchecked     = core.Unchecked
+	Checked       = core.Checked
+	Indeterminate = core.Indeterminate // some descendants are checked
+)
+
+// TreeChecks holds the check states of a tree and keeps them consistent, see core.TreeChecks.
+type TreeChecks = core.TreeChecks
+
+// NewTreeChecks walks the tree with children and parent; parent returns nil for root items.
+func NewTreeChecks(children func(item TreeItem) []TreeItem, parent func(item TreeItem) TreeItem) *TreeChecks {
+	return core.NewTreeChecks(children, parent)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+// TreeModel supplies the items of a TreeView as they are expanded, see TreeView.SetModel.
+// Items are map keys, so they must be comparable and stay the same across calls,
+// pointers for example.
+type TreeModel interface {
+	// Children returns the children of parent, or the root items if parent is nil.
+	Children(parent TreeItem) []TreeItem
+	// HasChildren reports whether item can be expanded, without loading its children.
+	HasChildren(item TreeItem) bool
+}
+
+// treeInsert is a child to insert after another, or first if after is nil.
+type treeInsert struct {
+	item, after TreeItem
+}
+
+// treeChildDiff turns the old children of a node into the new ones.
+type treeChildDiff struct {
+	children []TreeItem   // the new children, without duplicates
+	removed  []TreeItem   // to remove first
+	kept     []TreeItem   // still in place
+	inserted []treeInsert // to insert in order, after the removals
+}
+
+// diffTreeChildren keeps the old children that are still present in the same order,
+// so their nodes keep their state. Moved children are removed and inserted again.
+func diffTreeChildren(old, new []TreeItem) treeChildDiff {
+	oldIndex := make(map[TreeItem]int, len(old))
+	for i, item := range old {
+		oldIndex[item] = i
+	}
+
+	var diff treeChildDiff
+	seen := make(map[TreeItem]bool, len(new))
+	kept := make(map[TreeItem]bool, len(new))
+	last := -1
+	var after TreeItem
+	for _, item := range new {
+		if seen[item] {
+			continue
+		}
+		seen[item] = true
+		diff.children = append(diff.children, item)
+
+		if i, ok := oldIndex[item]; ok && i > last {
+			last = i
+			kept[item] = true
+			diff.kept = append(diff.kept, item)
+		} else {
+			diff.inserted = append(diff.inserted, treeInsert{item, after})
+		}
+		after = item
+	}
+
+	for _, item := range old {
+		if !kept[item] {
+			diff.removed = append(diff.removed, item)
+		}
+	}
+	return diff
+}
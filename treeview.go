@@ -9,18 +9,22 @@ import (
 	"syscall"
 	"unsafe"
 
+	"github.com/samuel-jimenez/windigo/core"
 	"github.com/samuel-jimenez/windigo/w32"
 )
 
 // TreeItem represents an item in a TreeView widget.
-type TreeItem interface {
-	Text() string    // Text returns the text of the item.
-	ImageIndex() int // ImageIndex is used only if SetImageList is called on the treeview
-}
+type TreeItem = core.TreeItem
 
 type treeViewItemInfo struct {
 	handle       w32.HTREEITEM
 	child2Handle map[TreeItem]w32.HTREEITEM
+
+	// Model mode only.
+	children []TreeItem
+	loaded   bool   // children were read from the model
+	text     string // text and image shown, to update the node only if they change
+	image    int
 }
 
 // StringTreeItem is helper for basic string lists.
@@ -40,10 +44,20 @@ type TreeView struct {
 	handle2Item map[w32.HTREEITEM]TreeItem
 	currItem    TreeItem
 
+	model TreeModel
+	roots []TreeItem
+
+	checks        *TreeChecks
+	settingChecks bool
+
+	dragEnabled bool
+	drop        DropHandler
+
 	onSelectedChange EventManager
 	onExpand         EventManager
 	onCollapse       EventManager
 	onViewChange     EventManager
+	onCheckChanged   EventManager
 }
 
 func NewTreeView(parent Controller) *TreeView {
@@ -147,8 +161,9 @@ func (tv *TreeView) InsertItem(item, parent, insertAfter TreeItem) error {
 	if hItem == 0 {
 		return errors.New("windigo: TVM_INSERTITEM failed")
 	}
-	tv.item2Info[item] = &treeViewItemInfo{hItem, make(map[TreeItem]w32.HTREEITEM)}
+	tv.item2Info[item] = &treeViewItemInfo{handle: hItem, child2Handle: make(map[TreeItem]w32.HTREEITEM)}
 	tv.handle2Item[hItem] = item
+	tv.checkAdded(item)
 	return nil
 }
 
@@ -171,21 +186,48 @@ func (tv *TreeView) UpdateItem(item TreeItem) bool {
 	return true
 }
 
+// DeleteItem deletes item along with its descendants.
 func (tv *TreeView) DeleteItem(item TreeItem) bool {
 	it := tv.item2Info[item]
 	if it == nil {
 		return false
 	}
+	return tv.deleteNode(it.handle)
+}
 
-	if w32.SendMessage(tv.hwnd, w32.TVM_DELETEITEM, 0, uintptr(it.handle)) == 0 {
+// deleteNode deletes the node hItem and forgets the items of its subtree.
+func (tv *TreeView) deleteNode(hItem w32.HTREEITEM) bool {
+	parent := tv.handle2Item[w32.HTREEITEM(w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_PARENT, uintptr(hItem)))]
+	tv.forgetNode(hItem)
+	if w32.SendMessage(tv.hwnd, w32.TVM_DELETEITEM, 0, uintptr(hItem)) == 0 {
 		return false
 	}
-
-	delete(tv.item2Info, item)
-	delete(tv.handle2Item, it.handle)
+	tv.checkUpdate(parent)
 	return true
 }
 
+// forgetNode forgets the items of the subtree of hItem. Items inserted again
+// at another node, as DragDrop.Move does, are kept.
+func (tv *TreeView) forgetNode(hItem w32.HTREEITEM) {
+	child := w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_CHILD, uintptr(hItem))
+	for ; child != 0; child = w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_NEXT, child) {
+		tv.forgetNode(w32.HTREEITEM(child))
+	}
+
+	item, ok := tv.handle2Item[hItem]
+	if !ok {
+		return
+	}
+	delete(tv.handle2Item, hItem)
+	if info := tv.item2Info[item]; info != nil && info.handle == hItem {
+		tv.checkForget(item)
+		delete(tv.item2Info, item)
+		if tv.currItem == item {
+			tv.currItem = nil
+		}
+	}
+}
+
 func (tv *TreeView) DeleteAllItems() bool {
 	if w32.SendMessage(tv.hwnd, w32.TVM_DELETEITEM, 0, 0) == 0 {
 		return false
@@ -193,10 +235,14 @@ func (tv *TreeView) DeleteAllItems() bool {
 
 	tv.item2Info = make(map[TreeItem]*treeViewItemInfo)
 	tv.handle2Item = make(map[w32.HTREEITEM]TreeItem)
+	if tv.checks != nil {
+		tv.checks = tv.newTreeChecks()
+	}
 	return true
 }
 
 func (tv *TreeView) Expand(item TreeItem) bool {
+	tv.loadChildren(item)
 	if w32.SendMessage(tv.hwnd, w32.TVM_EXPAND, w32.TVE_EXPAND, uintptr(tv.item2Info[item].handle)) == 0 {
 		return false
 	}
@@ -253,6 +299,12 @@ func (tv *TreeView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 		nm := (*w32.NMHDR)(unsafe.Pointer(lparam))
 
 		switch nm.Code {
+		case w32.TVN_ITEMEXPANDING:
+			nmtv := (*w32.NMTREEVIEW)(unsafe.Pointer(nm))
+			if nmtv.Action&w32.TVE_EXPAND != 0 {
+				tv.loadChildren(tv.handle2Item[nmtv.ItemNew.HItem])
+			}
+
 		case w32.TVN_ITEMEXPANDED:
 			nmtv := (*w32.NMTREEVIEW)(unsafe.Pointer(lparam))
 
@@ -270,6 +322,20 @@ func (tv *TreeView) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 			case w32.TVE_TOGGLE:
 			}
 
+		case w32.TVN_ITEMCHANGED:
+			change := (*w32.NMTVITEMCHANGE)(unsafe.Pointer(nm))
+			if change.UChanged&w32.TVIF_STATE != 0 &&
+				(change.UStateNew^change.UStateOld)&w32.TVIS_STATEIMAGEMASK != 0 {
+				tv.stateImageChanged(change.HItem, change.UStateOld)
+			}
+
+		case w32.TVN_BEGINDRAG:
+			nmtv := (*w32.NMTREEVIEW)(unsafe.Pointer(nm))
+			if item, ok := tv.handle2Item[nmtv.ItemNew.HItem]; ok && tv.dragEnabled {
+				image := w32.HIMAGELIST(w32.SendMessage(tv.hwnd, w32.TVM_CREATEDRAGIMAGE, 0, uintptr(nmtv.ItemNew.HItem)))
+				beginDrag(DragDrop{Source: tv, TreeItems: []TreeItem{item}}, image, int(nmtv.PtDrag.X), int(nmtv.PtDrag.Y))
+			}
+
 		case w32.TVN_SELCHANGED:
 			nmtv := (*w32.NMTREEVIEW)(unsafe.Pointer(lparam))
 			tv.currItem = tv.handle2Item[nmtv.ItemNew.HItem]
@@ -0,0 +1,165 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"unsafe"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// State images of TVS_CHECKBOXES with TVS_EX_PARTIALCHECKBOXES, by CheckState.
+var checkStateImages = [...]uint32{
+	Unchecked:     1,
+	Checked:       2,
+	Indeterminate: 3,
+}
+
+// EnableCheckBoxes shows tri-state check boxes. Checking an item checks its descendants,
+// and a parent shows whether none, some or all of its children are checked.
+func (tv *TreeView) EnableCheckBoxes(enable bool) {
+	// TVS_CHECKBOXES must be set after the tree view is created.
+	ToggleStyle(tv.hwnd, enable, w32.TVS_CHECKBOXES)
+	if !enable {
+		tv.checks = nil
+		return
+	}
+	w32.SendMessage(tv.hwnd, w32.TVM_SETEXTENDEDSTYLE, w32.TVS_EX_PARTIALCHECKBOXES, w32.TVS_EX_PARTIALCHECKBOXES)
+	tv.checks = tv.newTreeChecks()
+	for item := range tv.item2Info {
+		tv.setStateImage(item, Unchecked)
+	}
+}
+
+func (tv *TreeView) newTreeChecks() *TreeChecks {
+	return NewTreeChecks(tv.childItems, tv.parentItem)
+}
+
+// CheckBoxes reports whether the tree view shows check boxes.
+func (tv *TreeView) CheckBoxes() bool {
+	return tv.checks != nil
+}
+
+func (tv *TreeView) CheckState(item TreeItem) CheckState {
+	if tv.checks == nil {
+		return Unchecked
+	}
+	return tv.checks.State(item)
+}
+
+func (tv *TreeView) Checked(item TreeItem) bool {
+	return tv.CheckState(item) == Checked
+}
+
+// SetChecked checks or unchecks item and its descendants and updates its ancestors.
+func (tv *TreeView) SetChecked(item TreeItem, checked bool) bool {
+	if tv.checks == nil || tv.item2Info[item] == nil {
+		return false
+	}
+	tv.applyChecks(tv.checks.Set(item, checked))
+	return true
+}
+
+// CheckedItems returns the checked items, depth first.
+// Children the tree view has not loaded from its model are not included.
+func (tv *TreeView) CheckedItems() []TreeItem {
+	if tv.checks == nil {
+		return nil
+	}
+	return tv.checks.Checked(nil)
+}
+
+// OnCheckChanged fires when the user checks or unchecks an item. Event.Data is the TreeItem.
+func (tv *TreeView) OnCheckChanged() *EventManager {
+	return &tv.onCheckChanged
+}
+
+// childItems returns the children of item, or the root items if item is nil.
+func (tv *TreeView) childItems(item TreeItem) []TreeItem {
+	hItem := uintptr(w32.TVI_ROOT)
+	if item != nil {
+		info := tv.item2Info[item]
+		if info == nil {
+			return nil
+		}
+		hItem = uintptr(info.handle)
+	}
+
+	var children []TreeItem
+	child := w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_CHILD, hItem)
+	for ; child != 0; child = w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_NEXT, child) {
+		// Placeholders of unloaded nodes have no item.
+		if item, ok := tv.handle2Item[w32.HTREEITEM(child)]; ok {
+			children = append(children, item)
+		}
+	}
+	return children
+}
+
+// parentItem returns the parent of item, or nil for a root item.
+func (tv *TreeView) parentItem(item TreeItem) TreeItem {
+	info := tv.item2Info[item]
+	if info == nil {
+		return nil
+	}
+	parent := w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_PARENT, uintptr(info.handle))
+	return tv.handle2Item[w32.HTREEITEM(parent)]
+}
+
+func (tv *TreeView) setStateImage(item TreeItem, state CheckState) {
+	info := tv.item2Info[item]
+	if info == nil {
+		return
+	}
+	tvi := w32.TVITEM{
+		Mask:      w32.TVIF_STATE,
+		HItem:     info.handle,
+		State:     checkStateImages[state] << 12,
+		StateMask: w32.TVIS_STATEIMAGEMASK,
+	}
+	tv.settingChecks = true
+	w32.SendMessage(tv.hwnd, w32.TVM_SETITEM, 0, uintptr(unsafe.Pointer(&tvi)))
+	tv.settingChecks = false
+}
+
+func (tv *TreeView) applyChecks(changed []TreeItem) {
+	for _, item := range changed {
+		tv.setStateImage(item, tv.checks.State(item))
+	}
+}
+
+// stateImageChanged turns a click on a check box into a check or uncheck.
+// The control cycles through the three images itself, so the old image tells what the user meant.
+func (tv *TreeView) stateImageChanged(hItem w32.HTREEITEM, oldState uint32) {
+	item, ok := tv.handle2Item[hItem]
+	if tv.settingChecks || tv.checks == nil || !ok {
+		return
+	}
+	checked := oldState&w32.TVIS_STATEIMAGEMASK != checkStateImages[Checked]<<12
+	tv.applyChecks(tv.checks.Set(item, checked))
+	// Reset the image of item even if its state is unchanged, undoing the control's cycling.
+	tv.setStateImage(item, tv.checks.State(item))
+	tv.onCheckChanged.Fire(NewEvent(tv, item))
+}
+
+func (tv *TreeView) checkAdded(item TreeItem) {
+	if tv.checks == nil {
+		return
+	}
+	tv.setStateImage(item, Unchecked)
+	tv.applyChecks(tv.checks.Added(item))
+}
+
+func (tv *TreeView) checkForget(item TreeItem) {
+	if tv.checks != nil {
+		tv.checks.Forget(item)
+	}
+}
+
+func (tv *TreeView) checkUpdate(parent TreeItem) {
+	if tv.checks != nil {
+		tv.applyChecks(tv.checks.Update(parent))
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+
+	"github.com/samuel-jimenez/windigo/w32"
+)
+
+// SetModel replaces the items of the tree view with the root items of model.
+// Children are read from the model when their parent is first expanded;
+// until then a node that HasChildren holds a placeholder child to show its button.
+// A nil model clears the tree view.
+func (tv *TreeView) SetModel(model TreeModel) {
+	tv.DeleteAllItems()
+	tv.model, tv.roots = model, nil
+	if model != nil {
+		tv.roots = tv.refreshChildren(w32.TVI_ROOT, nil, nil)
+	}
+}
+
+func (tv *TreeView) Model() TreeModel {
+	return tv.model
+}
+
+// Refresh rereads item from the model, or the root items if item is nil.
+// Children that are still present keep their nodes, with their selection and expanded state;
+// the children of nodes whose children were loaded are refreshed in turn.
+// Only nodes whose text or image changed are redrawn.
+func (tv *TreeView) Refresh(item TreeItem) error {
+	if tv.model == nil {
+		return errors.New("windigo: TreeView has no model")
+	}
+	if item == nil {
+		tv.roots = tv.refreshChildren(w32.TVI_ROOT, tv.roots, nil)
+		return nil
+	}
+
+	info := tv.item2Info[item]
+	if info == nil {
+		return errors.New("windigo: invalid item")
+	}
+	if text, image := item.Text(), item.ImageIndex(); text != info.text || image != info.image {
+		info.text, info.image = text, image
+		tv.UpdateItem(item)
+	}
+	if info.loaded {
+		info.children = tv.refreshChildren(info.handle, info.children, item)
+	} else {
+		tv.setPlaceholder(info.handle, tv.model.HasChildren(item))
+	}
+	return nil
+}
+
+// refreshChildren updates the children of the node hParent of parent from old
+// to what the model has now and returns them.
+func (tv *TreeView) refreshChildren(hParent w32.HTREEITEM, old []TreeItem, parent TreeItem) []TreeItem {
+	diff := diffTreeChildren(old, tv.model.Children(parent))
+	for _, item := range diff.removed {
+		tv.removeModelItem(item)
+	}
+	for _, insert := range diff.inserted {
+		hAfter := w32.TVI_FIRST
+		if info := tv.item2Info[insert.after]; info != nil {
+			hAfter = info.handle
+		}
+		tv.insertModelItem(insert.item, hParent, hAfter)
+	}
+	for _, item := range diff.kept {
+		tv.Refresh(item)
+	}
+	return diff.children
+}
+
+func (tv *TreeView) insertModelItem(item TreeItem, hParent, hAfter w32.HTREEITEM) {
+	var tvins w32.TVINSERTSTRUCT
+	tvins.HParent, tvins.HInsertAfter = hParent, hAfter
+	tvins.Item.Mask = w32.TVIF_TEXT
+	tvins.Item.PszText = uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(item.Text())))
+	tv.applyImage(&tvins.Item, item)
+
+	hItem := w32.HTREEITEM(w32.SendMessage(tv.hwnd, w32.TVM_INSERTITEM, 0, uintptr(unsafe.Pointer(&tvins))))
+	if hItem == 0 {
+		return
+	}
+	tv.item2Info[item] = &treeViewItemInfo{
+		handle:       hItem,
+		child2Handle: make(map[TreeItem]w32.HTREEITEM),
+		text:         item.Text(),
+		image:        item.ImageIndex(),
+	}
+	tv.handle2Item[hItem] = item
+	tv.setPlaceholder(hItem, tv.model.HasChildren(item))
+	tv.checkAdded(item)
+}
+
+// removeModelItem deletes the node of item and forgets its descendants.
+func (tv *TreeView) removeModelItem(item TreeItem) {
+	info := tv.item2Info[item]
+	if info == nil {
+		return
+	}
+	parent := tv.parentItem(item)
+	tv.checkForget(item)
+	tv.forget(item)
+	w32.SendMessage(tv.hwnd, w32.TVM_DELETEITEM, 0, uintptr(info.handle))
+	tv.checkUpdate(parent)
+}
+
+func (tv *TreeView) forget(item TreeItem) {
+	info := tv.item2Info[item]
+	if info == nil {
+		return
+	}
+	for _, child := range info.children {
+		tv.forget(child)
+	}
+	if tv.currItem == item {
+		tv.currItem = nil
+	}
+	delete(tv.item2Info, item)
+	delete(tv.handle2Item, info.handle)
+}
+
+// setPlaceholder adds or removes the placeholder child of an unloaded node.
+func (tv *TreeView) setPlaceholder(hItem w32.HTREEITEM, hasChildren bool) {
+	child := w32.SendMessage(tv.hwnd, w32.TVM_GETNEXTITEM, w32.TVGN_CHILD, uintptr(hItem))
+	if hasChildren && child == 0 {
+		var tvins w32.TVINSERTSTRUCT
+		tvins.HParent, tvins.HInsertAfter = hItem, w32.TVI_LAST
+		w32.SendMessage(tv.hwnd, w32.TVM_INSERTITEM, 0, uintptr(unsafe.Pointer(&tvins)))
+	} else if !hasChildren && child != 0 {
+		w32.SendMessage(tv.hwnd, w32.TVM_DELETEITEM, 0, child)
+	}
+}
+
+// loadChildren replaces the placeholder of item with its children from the model.
+func (tv *TreeView) loadChildren(item TreeItem) {
+	info := tv.item2Info[item]
+	if tv.model == nil || info == nil || info.loaded {
+		return
+	}
+	info.loaded = true
+	tv.setPlaceholder(info.handle, false)
+	info.children = tv.refreshChildren(info.handle, nil, item)
+}
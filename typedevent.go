@@ -0,0 +1,99 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TypedEventHandler receives the event together with its data as T.
+type TypedEventHandler[T any] func(arg *Event, data T)
+
+// TypedEventManager is a view of an EventManager whose handlers get Event.Data as T.
+// Handlers are stored in the EventManager, so typed and untyped handlers share
+// the same order and stop propagation for each other.
+type TypedEventManager[T any] struct {
+	evm *EventManager
+}
+
+func NewTypedEventManager[T any](evm *EventManager) TypedEventManager[T] {
+	return TypedEventManager[T]{evm}
+}
+
+// Untyped returns the underlying EventManager.
+func (typed TypedEventManager[T]) Untyped() *EventManager {
+	return typed.evm
+}
+
+// wrap converts handler to an EventHandler. Nil data is passed as the zero T,
+// data of any other type panics as the event is not the one the manager was typed for.
+func (typed TypedEventManager[T]) wrap(handler TypedEventHandler[T]) EventHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(arg *Event) {
+		var data T
+		if arg != nil && arg.Data != nil {
+			var ok bool
+			if data, ok = arg.Data.(T); !ok {
+				panic(fmt.Sprintf("windigo: typed event handler expects %v data, got %T", reflect.TypeFor[T](), arg.Data))
+			}
+		}
+		handler(arg, data)
+	}
+}
+
+func (typed TypedEventManager[T]) Subscribe(handler TypedEventHandler[T]) Subscription {
+	return typed.evm.Subscribe(typed.wrap(handler))
+}
+
+func (typed TypedEventManager[T]) Bind(handler TypedEventHandler[T]) {
+	typed.evm.Bind(typed.wrap(handler))
+}
+
+func (typed TypedEventManager[T]) Fire(sender Controller, data T) {
+	typed.evm.Fire(NewEvent(sender, data))
+}
+
+// MouseEvents types OnLBDown, OnLBUp, OnMouseMove and the other mouse events.
+func MouseEvents(evm *EventManager) TypedEventManager[*MouseEventData] {
+	return NewTypedEventManager[*MouseEventData](evm)
+}
+
+// SizeEvents types OnSize.
+func SizeEvents(evm *EventManager) TypedEventManager[*SizeEventData] {
+	return NewTypedEventManager[*SizeEventData](evm)
+}
+
+// KeyUpEvents types OnKeyUp.
+func KeyUpEvents(evm *EventManager) TypedEventManager[*KeyUpEventData] {
+	return NewTypedEventManager[*KeyUpEventData](evm)
+}
+
+// PaintEvents types OnPaint.
+func PaintEvents(evm *EventManager) TypedEventManager[*PaintEventData] {
+	return NewTypedEventManager[*PaintEventData](evm)
+}
+
+// DropFilesEvents types OnDropFiles.
+func DropFilesEvents(evm *EventManager) TypedEventManager[*DropFilesEventData] {
+	return NewTypedEventManager[*DropFilesEventData](evm)
+}
+
+// ListViewEvents types the ListView OnClick, OnDoubleClick and OnRClick events.
+func ListViewEvents(evm *EventManager) TypedEventManager[ListViewEvent] {
+	return NewTypedEventManager[ListViewEvent](evm)
+}
+
+// LabelEditEvents types ListView OnEndLabelEdit.
+func LabelEditEvents(evm *EventManager) TypedEventManager[*LabelEditEventData] {
+	return NewTypedEventManager[*LabelEditEventData](evm)
+}
+
+// ListItemEvents types the ListView OnCheckChanged, OnItemChanging and OnItemChanged events.
+func ListItemEvents(evm *EventManager) TypedEventManager[ListItem] {
+	return NewTypedEventManager[ListItem](evm)
+}
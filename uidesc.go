@@ -0,0 +1,39 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"io"
+
+	"github.com/samuel-jimenez/windigo/core"
+)
+
+// UIElement describes a control and its children in a declarative UI document,
+// see core.UIElement for the format.
+type UIElement = core.UIElement
+
+// UIFont describes a font. Style is a space separated list of
+// bold, italic, underline and strikeout.
+type UIFont = core.UIFont
+
+// ParseDirection parses the name of a Direction, such as "TopLeft". Empty is Top.
+func ParseDirection(name string) (Direction, error) {
+	return core.ParseDirection(name)
+}
+
+// ParseColor parses a color written as "#rrggbb".
+func ParseColor(text string) (Color, error) {
+	return core.ParseColor(text)
+}
+
+// ParseUIJSON reads and validates a JSON UI document.
+func ParseUIJSON(r io.Reader) (*UIElement, error) {
+	return core.ParseUIJSON(r)
+}
+
+// ParseUIXML reads and validates an XML UI document.
+func ParseUIXML(r io.Reader) (*UIElement, error) {
+	return core.ParseUIXML(r)
+}
@@ -0,0 +1,199 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/samuel-jimenez/windigo/core"
+)
+
+// LoadUIJSON builds the form described by a JSON UI document.
+func LoadUIJSON(r io.Reader) (*Form, map[string]Controller, error) {
+	root, err := ParseUIJSON(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return LoadUI(root)
+}
+
+// LoadUIXML builds the form described by an XML UI document.
+func LoadUIXML(r io.Reader) (*Form, map[string]Controller, error) {
+	root, err := ParseUIXML(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return LoadUI(root)
+}
+
+// LoadUI builds the form described by root and returns it with
+// every named control, so handlers can be bound afterwards.
+// Children are docked in document order under their names, see SimpleDock.DockAs.
+func LoadUI(root *UIElement) (*Form, map[string]Controller, error) {
+	if err := root.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	form := NewForm(nil)
+	controls := map[string]Controller{}
+	if err := applyUI(form, root, controls); err != nil {
+		form.Close()
+		return nil, nil, err
+	}
+
+	dock := NewSimpleDock(form)
+	if err := buildUIChildren(form, dock, root, controls); err != nil {
+		form.Close()
+		return nil, nil, err
+	}
+	return form, controls, nil
+}
+
+func buildUIChildren(parent Controller, dock *SimpleDock, element *UIElement, controls map[string]Controller) error {
+	if element.Paddings != "" {
+		left, top, right, btm, _ := core.ParseEdges(element.Paddings)
+		dock.SetPaddings(left, top, right, btm)
+	}
+	for _, child := range element.Children {
+		control, err := buildUI(parent, child, controls)
+		if err != nil {
+			return err
+		}
+		dir, _ := ParseDirection(child.Dock)
+		if err := dock.DockAs(control, dir, child.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildUI creates the control for element and its children.
+func buildUI(parent Controller, element *UIElement, controls map[string]Controller) (Controller, error) {
+	var control Controller
+	switch element.Type {
+	case "AutoPanel", "GroupAutoPanel":
+		var panel *AutoPanel
+		if element.Type == "AutoPanel" {
+			panel = NewAutoPanel(parent)
+		} else {
+			panel = NewGroupAutoPanel(parent)
+		}
+		if err := buildUIChildren(panel, panel.SimpleDock, element, controls); err != nil {
+			return nil, err
+		}
+		control = panel
+	case "Label":
+		control = NewLabel(parent)
+	case "Edit":
+		control = NewEdit(parent)
+	case "MultiEdit":
+		control = NewMultiEdit(parent)
+	case "PushButton":
+		control = NewPushButton(parent)
+	case "CheckBox":
+		control = NewCheckBox(parent)
+	case "RadioButton":
+		control = NewRadioButton(parent)
+	case "ComboBox", "ListComboBox":
+		var combo *ComboBox
+		if element.Type == "ComboBox" {
+			combo = NewComboBox(parent)
+		} else {
+			combo = NewListComboBox(parent)
+		}
+		for _, item := range element.Items {
+			combo.AddItem(item)
+		}
+		control = combo
+	case "LabeledEdit":
+		field := NewLabeledEdit(parent, element.Label)
+		setLabelWidth(field, element)
+		control = field
+	case "LabeledComboBox", "LabeledListComboBox":
+		var field *LabeledComboBox
+		if element.Type == "LabeledComboBox" {
+			field = NewLabeledComboBox(parent, element.Label)
+		} else {
+			field = NewLabeledListComboBox(parent, element.Label)
+		}
+		for _, item := range element.Items {
+			field.AddItem(item)
+		}
+		setLabelWidth(field, element)
+		control = field
+	case "LabeledLabel":
+		control = NewLabeledLabel(parent, element.Label)
+	case "LabeledCheckBox":
+		control = NewLabeledCheckBox(parent, element.Label)
+	default:
+		return nil, fmt.Errorf("windigo: ui: unknown type %q", element.Type)
+	}
+
+	if err := applyUI(control, element, controls); err != nil {
+		return nil, err
+	}
+	return control, nil
+}
+
+// setLabelWidth splits the element width between label and field.
+func setLabelWidth(field DiffLabelable, element *UIElement) {
+	if element.LabelWidth == 0 {
+		return
+	}
+	field.Label().SetSize(element.LabelWidth, field.Label().Height())
+	if element.Width != 0 && element.Height != 0 {
+		field.SetLabeledSize(element.LabelWidth, element.Width-element.LabelWidth, element.Height)
+	}
+}
+
+// applyUI sets the properties common to every control and records its name.
+func applyUI(control Controller, element *UIElement, controls map[string]Controller) error {
+	if element.Text != "" {
+		control.SetText(element.Text)
+	}
+	if element.Width != 0 || element.Height != 0 {
+		width, height := control.Size()
+		if element.Width != 0 {
+			width = element.Width
+		}
+		if element.Height != 0 {
+			height = element.Height
+		}
+		control.SetSize(width, height)
+	}
+	if element.Margins != "" {
+		left, top, right, btm, err := core.ParseEdges(element.Margins)
+		if err != nil {
+			return err
+		}
+		control.SetMargins(left, top, right, btm)
+	}
+	if element.Font != nil {
+		style, err := core.ParseFontStyle(element.Font.Style)
+		if err != nil {
+			return err
+		}
+		control.SetFont(NewFont(element.Font.Family, element.Font.Size, style))
+	}
+	if element.FG != "" {
+		color, err := ParseColor(element.FG)
+		if err != nil {
+			return err
+		}
+		control.SetFGColor(color)
+	}
+	if element.BG != "" {
+		color, err := ParseColor(element.BG)
+		if err != nil {
+			return err
+		}
+		control.SetBGColor(color)
+	}
+	if element.Name != "" {
+		controls[element.Name] = control
+	}
+	return nil
+}
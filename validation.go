@@ -0,0 +1,125 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import "github.com/samuel-jimenez/windigo/core"
+
+// Validator checks the text of a field and describes what is wrong with it.
+// Any func(string) error can be used as a custom validator.
+type Validator = core.Validator
+
+// Required rejects empty or blank text.
+func Required(message string) Validator {
+	return core.Required(message)
+}
+
+// MatchRegexp rejects text that does not match pattern. It panics if pattern does not compile.
+// Empty text is accepted, combine with Required if needed.
+func MatchRegexp(pattern, message string) Validator {
+	return core.MatchRegexp(pattern, message)
+}
+
+// IntRange rejects text that is not an integer between min and max inclusive.
+// Empty text is accepted.
+func IntRange(min, max int) Validator {
+	return core.IntRange(min, max)
+}
+
+// FloatRange rejects text that is not a number between min and max inclusive.
+// Empty text is accepted.
+func FloatRange(min, max float64) Validator {
+	return core.FloatRange(min, max)
+}
+
+// MaxLength rejects text longer than length characters.
+func MaxLength(length int) Validator {
+	return core.MaxLength(length)
+}
+
+// ValidationField is a field checked by a list of validators.
+type ValidationField struct {
+	field *core.ValidationField
+
+	onValidated EventManager
+}
+
+func (field *ValidationField) Name() string {
+	return field.field.Name()
+}
+
+// Err is the result of the last Validate.
+func (field *ValidationField) Err() error {
+	return field.field.Err()
+}
+
+// Validate runs the validators in order and keeps the first error.
+func (field *ValidationField) Validate() error {
+	return field.field.Validate()
+}
+
+// OnValidated fires after every Validate. Event.Data is the *ValidationField.
+func (field *ValidationField) OnValidated() *EventManager {
+	return &field.onValidated
+}
+
+// ValidationGroup holds the fields of a form.
+type ValidationGroup struct {
+	group  *core.ValidationGroup
+	fields []*ValidationField
+
+	onValidated EventManager
+}
+
+func NewValidationGroup() *ValidationGroup {
+	group := &ValidationGroup{group: core.NewValidationGroup()}
+	group.group.Validated = func(*core.ValidationGroup) {
+		group.onValidated.Fire(NewEvent(nil, group))
+	}
+	return group
+}
+
+// Add a field named name whose text is read by text.
+func (group *ValidationGroup) Add(name string, text func() string, validators ...Validator) *ValidationField {
+	field := &ValidationField{field: group.group.Add(name, text, validators...)}
+	field.field.Validated = func(*core.ValidationField) {
+		field.onValidated.Fire(NewEvent(nil, field))
+	}
+	group.fields = append(group.fields, field)
+	return field
+}
+
+func (group *ValidationGroup) Fields() []*ValidationField {
+	return group.fields
+}
+
+// Validate every field and report whether all are valid.
+func (group *ValidationGroup) Validate() bool {
+	return group.group.Validate()
+}
+
+// Valid reports whether all fields passed their last validation.
+func (group *ValidationGroup) Valid() bool {
+	return group.group.Valid()
+}
+
+// FirstInvalid returns the first field that failed its last validation.
+func (group *ValidationGroup) FirstInvalid() *ValidationField {
+	for _, field := range group.fields {
+		if field.Err() != nil {
+			return field
+		}
+	}
+	return nil
+}
+
+// Messages lists the errors of the last validation as "name: error".
+func (group *ValidationGroup) Messages() []string {
+	return group.group.Messages()
+}
+
+// OnValidated fires after any field was validated. Event.Data is the *ValidationGroup.
+func (group *ValidationGroup) OnValidated() *EventManager {
+	return &group.onValidated
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (C) 2025 The Windigo Authors. All Rights Reserved.
+ */
+
+package windigo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FormValidator validates the fields of a form as the user edits them,
+// marks invalid fields with colors and a tooltip and lists the errors in an ErrorPanel.
+type FormValidator struct {
+	*ValidationGroup
+
+	tip   *ToolTip
+	panel *ErrorPanel
+
+	// Colors of invalid fields.
+	InvalidFG, InvalidBG Color
+	// ValidText is shown in the ErrorPanel when all fields are valid.
+	ValidText string
+
+	controls map[*ValidationField]Controller
+}
+
+func NewFormValidator(parent Controller) *FormValidator {
+	validator := &FormValidator{
+		ValidationGroup: NewValidationGroup(),
+		tip:             NewToolTip(parent),
+		InvalidFG:       RGB(128, 0, 0),
+		InvalidBG:       RGB(255, 220, 220),
+		ValidText:       "No errors",
+		controls:        map[*ValidationField]Controller{},
+	}
+	validator.OnValidated().Subscribe(func(*Event) { validator.showErrors() })
+	return validator
+}
+
+// SetErrorPanel collects the error messages in panel.
+func (validator *FormValidator) SetErrorPanel(panel *ErrorPanel) {
+	validator.panel = panel
+}
+
+// Attach validators to an Edit, ComboBox, LabeledEdit or LabeledComboBox.
+// The field is validated when its text changes and when it loses focus.
+func (validator *FormValidator) Attach(control any, name string, validators ...Validator) (*ValidationField, error) {
+	var field *ValidationField
+	var target Controller
+	switch control := control.(type) {
+	case *Edit:
+		field = validator.Add(name, control.Text, validators...)
+		control.OnChange().Subscribe(func(*Event) { field.Validate() })
+		control.OnKillFocus().Subscribe(func(*Event) { field.Validate() })
+		target = control
+	case *LabeledEdit:
+		return validator.Attach(control.Edit, name, validators...)
+	case *ComboBox:
+		field = validator.Add(name, control.Text, validators...)
+		control.OnChange().Subscribe(func(*Event) { field.Validate() })
+		control.OnSelectedEnd().Subscribe(func(*Event) { field.Validate() })
+		control.OnKillFocus().Subscribe(func(*Event) { field.Validate() })
+		target = control
+	case *LabeledComboBox:
+		return validator.Attach(control.ComboBox, name, validators...)
+	default:
+		return nil, fmt.Errorf("windigo: cannot validate %T", control)
+	}
+
+	validator.controls[field] = target
+	field.OnValidated().Subscribe(func(*Event) { validator.mark(field) })
+	return field, nil
+}
+
+// mark colors the control of field and sets its tooltip.
+func (validator *FormValidator) mark(field *ValidationField) {
+	control := validator.controls[field]
+	validator.tip.RemoveTip(control)
+	if err := field.Err(); err != nil {
+		control.SetFGColor(validator.InvalidFG)
+		control.SetBGColor(validator.InvalidBG)
+		validator.tip.SetTip(control, err.Error())
+	} else {
+		control.ClearFGColor()
+		control.ClearBGColor()
+	}
+	control.Invalidate(true)
+}
+
+func (validator *FormValidator) showErrors() {
+	if validator.panel == nil {
+		return
+	}
+	if messages := validator.Messages(); len(messages) > 0 {
+		validator.panel.Errorf("%s", strings.Join(messages, "\n"))
+	} else {
+		validator.panel.Printf("%s", validator.ValidText)
+	}
+}
+
+// ValidateAndFocus validates every field and focuses the first invalid one.
+func (validator *FormValidator) ValidateAndFocus() bool {
+	if validator.Validate() {
+		return true
+	}
+	if control, ok := validator.controls[validator.FirstInvalid()]; ok {
+		control.SetFocus()
+	}
+	return false
+}
@@ -13,15 +13,21 @@ import (
 var (
 	modcomctl32 = syscall.NewLazyDLL("comctl32.dll")
 
-	procInitCommonControlsEx    = modcomctl32.NewProc("InitCommonControlsEx")
-	procImageList_Create        = modcomctl32.NewProc("ImageList_Create")
-	procImageList_Destroy       = modcomctl32.NewProc("ImageList_Destroy")
-	procImageList_GetImageCount = modcomctl32.NewProc("ImageList_GetImageCount")
-	procImageList_SetImageCount = modcomctl32.NewProc("ImageList_SetImageCount")
-	procImageList_Add           = modcomctl32.NewProc("ImageList_Add")
-	procImageList_ReplaceIcon   = modcomctl32.NewProc("ImageList_ReplaceIcon")
-	procImageList_Remove        = modcomctl32.NewProc("ImageList_Remove")
-	procTrackMouseEvent         = modcomctl32.NewProc("_TrackMouseEvent")
+	procInitCommonControlsEx     = modcomctl32.NewProc("InitCommonControlsEx")
+	procImageList_Create         = modcomctl32.NewProc("ImageList_Create")
+	procImageList_Destroy        = modcomctl32.NewProc("ImageList_Destroy")
+	procImageList_GetImageCount  = modcomctl32.NewProc("ImageList_GetImageCount")
+	procImageList_SetImageCount  = modcomctl32.NewProc("ImageList_SetImageCount")
+	procImageList_Add            = modcomctl32.NewProc("ImageList_Add")
+	procImageList_ReplaceIcon    = modcomctl32.NewProc("ImageList_ReplaceIcon")
+	procImageList_Remove         = modcomctl32.NewProc("ImageList_Remove")
+	procImageList_BeginDrag      = modcomctl32.NewProc("ImageList_BeginDrag")
+	procImageList_DragEnter      = modcomctl32.NewProc("ImageList_DragEnter")
+	procImageList_DragMove       = modcomctl32.NewProc("ImageList_DragMove")
+	procImageList_DragLeave      = modcomctl32.NewProc("ImageList_DragLeave")
+	procImageList_DragShowNolock = modcomctl32.NewProc("ImageList_DragShowNolock")
+	procImageList_EndDrag        = modcomctl32.NewProc("ImageList_EndDrag")
+	procTrackMouseEvent          = modcomctl32.NewProc("_TrackMouseEvent")
 )
 
 func InitCommonControlsEx(lpInitCtrls *INITCOMMONCONTROLSEX) bool {
@@ -102,6 +108,53 @@ func ImageList_RemoveAll(himl HIMAGELIST) bool {
 	return ImageList_Remove(himl, -1)
 }
 
+func ImageList_BeginDrag(himl HIMAGELIST, iTrack, dxHotspot, dyHotspot int) bool {
+	ret, _, _ := procImageList_BeginDrag.Call(
+		uintptr(himl),
+		uintptr(iTrack),
+		uintptr(dxHotspot),
+		uintptr(dyHotspot))
+
+	return ret != 0
+}
+
+// ImageList_DragEnter shows the drag image at x, y relative to the window rectangle of hwndLock,
+// or to the screen if hwndLock is 0.
+func ImageList_DragEnter(hwndLock HWND, x, y int) bool {
+	ret, _, _ := procImageList_DragEnter.Call(
+		uintptr(hwndLock),
+		uintptr(x),
+		uintptr(y))
+
+	return ret != 0
+}
+
+func ImageList_DragMove(x, y int) bool {
+	ret, _, _ := procImageList_DragMove.Call(
+		uintptr(x),
+		uintptr(y))
+
+	return ret != 0
+}
+
+func ImageList_DragLeave(hwndLock HWND) bool {
+	ret, _, _ := procImageList_DragLeave.Call(
+		uintptr(hwndLock))
+
+	return ret != 0
+}
+
+func ImageList_DragShowNolock(show bool) bool {
+	ret, _, _ := procImageList_DragShowNolock.Call(
+		uintptr(BoolToBOOL(show)))
+
+	return ret != 0
+}
+
+func ImageList_EndDrag() {
+	procImageList_EndDrag.Call()
+}
+
 func TrackMouseEvent(tme *TRACKMOUSEEVENT) bool {
 	ret, _, _ := procTrackMouseEvent.Call(
 		uintptr(unsafe.Pointer(tme)))
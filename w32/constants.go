@@ -66,6 +66,16 @@ const (
 	ERROR_SERVICE_LOGON_FAILED       = 1069
 	ERROR_SERVICE_MARKED_FOR_DELETE  = 1072
 	ERROR_SERVICE_DEPENDENCY_DELETED = 1075
+	ERROR_HOTKEY_ALREADY_REGISTERED  = 1409
+)
+
+// RegisterHotKey fsModifiers
+const (
+	MOD_ALT      = 0x0001
+	MOD_CONTROL  = 0x0002
+	MOD_SHIFT    = 0x0004
+	MOD_WIN      = 0x0008
+	MOD_NOREPEAT = 0x4000
 )
 
 const (
@@ -1727,6 +1737,51 @@ const (
 	LVSCW_AUTOSIZE_USEHEADER = ^uintptr(1)
 )
 
+// ListView group constants
+const (
+	LVGF_NONE    = 0x00000000
+	LVGF_HEADER  = 0x00000001
+	LVGF_FOOTER  = 0x00000002
+	LVGF_STATE   = 0x00000004
+	LVGF_ALIGN   = 0x00000008
+	LVGF_GROUPID = 0x00000010
+
+	LVGS_NORMAL      = 0x00000000
+	LVGS_COLLAPSED   = 0x00000001
+	LVGS_HIDDEN      = 0x00000002
+	LVGS_NOHEADER    = 0x00000004
+	LVGS_COLLAPSIBLE = 0x00000008
+
+	LVGA_HEADER_LEFT = 0x00000001
+)
+
+// Custom draw constants
+const (
+	CDDS_PREPAINT     = 0x00000001
+	CDDS_POSTPAINT    = 0x00000002
+	CDDS_ITEM         = 0x00010000
+	CDDS_ITEMPREPAINT = CDDS_ITEM | CDDS_PREPAINT
+	CDDS_SUBITEM      = 0x00020000
+
+	CDRF_DODEFAULT         = 0x00000000
+	CDRF_NEWFONT           = 0x00000002
+	CDRF_SKIPDEFAULT       = 0x00000004
+	CDRF_NOTIFYPOSTPAINT   = 0x00000010
+	CDRF_NOTIFYITEMDRAW    = 0x00000020
+	CDRF_NOTIFYSUBITEMDRAW = 0x00000020
+
+	CLR_NONE    = 0xFFFFFFFF
+	CLR_DEFAULT = 0xFF000000
+)
+
+// ListView LVIR constants
+const (
+	LVIR_BOUNDS       = 0
+	LVIR_ICON         = 1
+	LVIR_LABEL        = 2
+	LVIR_SELECTBOUNDS = 3
+)
+
 // ListView LVNI constants
 const (
 	LVNI_ALL         = 0
@@ -1824,7 +1879,32 @@ const (
 	LVIF_COLUMNS     = 0x00000200
 )
 
-const LVFI_PARAM = 0x0001
+const (
+	LVFI_PARAM     = 0x0001
+	LVFI_STRING    = 0x0002
+	LVFI_SUBSTRING = 0x0004
+	LVFI_PARTIAL   = 0x0008
+	LVFI_WRAP      = 0x0020
+	LVFI_NEARESTXY = 0x0040
+)
+
+// Header control
+const (
+	HDM_FIRST    = 0x1200
+	HDM_GETITEMW = HDM_FIRST + 11
+	HDM_SETITEMW = HDM_FIRST + 12
+
+	HDI_FORMAT = 0x0004
+
+	HDF_SORTDOWN = 0x0200
+	HDF_SORTUP   = 0x0400
+)
+
+// LVM_SETITEMCOUNT flags
+const (
+	LVSICF_NOINVALIDATEALL = 0x00000001
+	LVSICF_NOSCROLL        = 0x00000002
+)
 
 // ListView item states
 const (
@@ -3123,7 +3203,17 @@ const (
 )
 
 const (
-	TVGN_CARET = 9
+	TVGN_ROOT            = 0x0000
+	TVGN_NEXT            = 0x0001
+	TVGN_PREVIOUS        = 0x0002
+	TVGN_PARENT          = 0x0003
+	TVGN_CHILD           = 0x0004
+	TVGN_FIRSTVISIBLE    = 0x0005
+	TVGN_NEXTVISIBLE     = 0x0006
+	TVGN_PREVIOUSVISIBLE = 0x0007
+	TVGN_DROPHILITE      = 0x0008
+	TVGN_CARET           = 0x0009
+	TVGN_LASTVISIBLE     = 0x000A
 )
 
 // TreeView messages
@@ -3263,6 +3353,15 @@ type NMTREEVIEW struct {
 	PtDrag  POINT
 }
 
+type NMTVITEMCHANGE struct {
+	Hdr       NMHDR
+	UChanged  uint32
+	HItem     HTREEITEM
+	UStateNew uint32
+	UStateOld uint32
+	LParam    uintptr
+}
+
 type NMTVDISPINFO struct {
 	Hdr  NMHDR
 	Item TVITEM
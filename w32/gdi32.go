@@ -55,6 +55,7 @@ var (
 	procStartPage                 = modgdi32.NewProc("StartPage")
 	procStretchBlt                = modgdi32.NewProc("StretchBlt")
 	procSetDIBitsToDevice         = modgdi32.NewProc("SetDIBitsToDevice")
+	procGetDIBits                 = modgdi32.NewProc("GetDIBits")
 	procChoosePixelFormat         = modgdi32.NewProc("ChoosePixelFormat")
 	procDescribePixelFormat       = modgdi32.NewProc("DescribePixelFormat")
 	procGetEnhMetaFilePixelFormat = modgdi32.NewProc("GetEnhMetaFilePixelFormat")
@@ -476,6 +477,19 @@ func SetDIBitsToDevice(hdc HDC, xDest, yDest, dwWidth, dwHeight, xSrc, ySrc int,
 	return int(ret)
 }
 
+func GetDIBits(hdc HDC, hbm HBITMAP, start, cLines uint, lpvBits unsafe.Pointer, lpbmi *BITMAPINFO, usage uint) int {
+	ret, _, _ := procGetDIBits.Call(
+		uintptr(hdc),
+		uintptr(hbm),
+		uintptr(start),
+		uintptr(cLines),
+		uintptr(lpvBits),
+		uintptr(unsafe.Pointer(lpbmi)),
+		uintptr(usage))
+
+	return int(ret)
+}
+
 func ChoosePixelFormat(hdc HDC, pfd *PIXELFORMATDESCRIPTOR) int {
 	ret, _, _ := procChoosePixelFormat.Call(
 		uintptr(hdc),
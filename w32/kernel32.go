@@ -27,6 +27,7 @@ var (
 	procGlobalFree                 = modkernel32.NewProc("GlobalFree")
 	procGlobalLock                 = modkernel32.NewProc("GlobalLock")
 	procGlobalUnlock               = modkernel32.NewProc("GlobalUnlock")
+	procGlobalSize                 = modkernel32.NewProc("GlobalSize")
 	procMoveMemory                 = modkernel32.NewProc("RtlMoveMemory")
 	procFindResource               = modkernel32.NewProc("FindResourceW")
 	procSizeofResource             = modkernel32.NewProc("SizeofResource")
@@ -122,6 +123,19 @@ func GlobalAlloc(uFlags uint, dwBytes uint32) HGLOBAL {
 	return HGLOBAL(ret)
 }
 
+// TryGlobalAlloc is GlobalAlloc returning the error instead of panicking.
+func TryGlobalAlloc(uFlags uint, dwBytes uint32) (HGLOBAL, error) {
+	ret, _, _ := procGlobalAlloc.Call(
+		uintptr(uFlags),
+		uintptr(dwBytes))
+
+	if ret == 0 {
+		return 0, syscall.GetLastError()
+	}
+
+	return HGLOBAL(ret), nil
+}
+
 func GlobalFree(hMem HGLOBAL) {
 	ret, _, _ := procGlobalFree.Call(uintptr(hMem))
 
@@ -146,6 +160,12 @@ func GlobalUnlock(hMem HGLOBAL) bool {
 	return ret != 0
 }
 
+func GlobalSize(hMem HGLOBAL) uint32 {
+	ret, _, _ := procGlobalSize.Call(uintptr(hMem))
+
+	return uint32(ret)
+}
+
 func MoveMemory(destination, source unsafe.Pointer, length uint32) {
 	procMoveMemory.Call(
 		uintptr(unsafe.Pointer(destination)),
@@ -0,0 +1,8 @@
+//go:build 386 || arm
+
+package w32
+
+// pointArgs passes a POINT by value: 32-bit Windows passes X and Y as two arguments.
+func pointArgs(x, y int) []uintptr {
+	return []uintptr{uintptr(int32(x)), uintptr(int32(y))}
+}
@@ -0,0 +1,8 @@
+//go:build amd64 || arm64
+
+package w32
+
+// pointArgs passes a POINT by value: 64-bit Windows packs it in one register.
+func pointArgs(x, y int) []uintptr {
+	return []uintptr{uintptr(uint32(x)) | uintptr(uint32(y))<<32}
+}
@@ -617,6 +617,91 @@ type NMLVDISPINFO struct {
 	Item LVITEM
 }
 
+// https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-lvgroup
+type LVGROUP struct {
+	CbSize               uint32
+	Mask                 uint32
+	PszHeader            *uint16
+	CchHeader            int32
+	PszFooter            *uint16
+	CchFooter            int32
+	IGroupId             int32
+	StateMask            uint32
+	State                uint32
+	UAlign               uint32
+	PszSubtitle          *uint16
+	CchSubtitle          uint32
+	PszTask              *uint16
+	CchTask              uint32
+	PszDescriptionTop    *uint16
+	CchDescriptionTop    uint32
+	PszDescriptionBottom *uint16
+	CchDescriptionBottom uint32
+	ITitleImage          int32
+	IExtendedImage       int32
+	IFirstItem           int32
+	CItems               uint32
+	PszSubsetTitle       *uint16
+	CchSubsetTitle       uint32
+}
+
+// https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmcustomdraw
+type NMCUSTOMDRAW struct {
+	Hdr         NMHDR
+	DwDrawStage uint32
+	Hdc         HDC
+	Rc          RECT
+	DwItemSpec  uintptr
+	UItemState  uint32
+	LItemlParam uintptr
+}
+
+// https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmlvcustomdraw
+type NMLVCUSTOMDRAW struct {
+	Nmcd        NMCUSTOMDRAW
+	ClrText     COLORREF
+	ClrTextBk   COLORREF
+	ISubItem    int32
+	DwItemType  uint32
+	ClrFace     COLORREF
+	IIconEffect int32
+	IIconPhase  int32
+	IPartId     int32
+	IStateId    int32
+	RcText      RECT
+	UAlign      uint32
+}
+
+// https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-hditemw
+type HDITEM struct {
+	Mask       uint32
+	Cxy        int32
+	PszText    *uint16
+	Hbm        HBITMAP
+	CchTextMax int32
+	Fmt        int32
+	LParam     uintptr
+	IImage     int32
+	IOrder     int32
+	Type       uint32
+	PvFilter   uintptr
+	State      uint32
+}
+
+// https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmlvcachehint
+type NMLVCACHEHINT struct {
+	Hdr   NMHDR
+	IFrom int32
+	ITo   int32
+}
+
+// https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmlvfinditemw
+type NMLVFINDITEM struct {
+	Hdr    NMHDR
+	IStart int32
+	Lvfi   LVFINDINFO
+}
+
 // http://msdn.microsoft.com/en-us/library/windows/desktop/bb775507.aspx
 type INITCOMMONCONTROLSEX struct {
 	DwSize uint32
@@ -65,6 +65,7 @@ var (
 	procMessageBox                    = moduser32.NewProc("MessageBoxW")
 	procGetSystemMetrics              = moduser32.NewProc("GetSystemMetrics")
 	procPostThreadMessageW            = moduser32.NewProc("PostThreadMessageW")
+	procRegisterWindowMessage         = moduser32.NewProc("RegisterWindowMessageW")
 	//procSysColorBrush            = moduser32.NewProc("GetSysColorBrush")
 	procCopyRect          = moduser32.NewProc("CopyRect")
 	procEqualRect         = moduser32.NewProc("EqualRect")
@@ -108,10 +109,13 @@ var (
 	procEmptyClipboard                = moduser32.NewProc("EmptyClipboard")
 	procGetClipboardFormatName        = moduser32.NewProc("GetClipboardFormatNameW")
 	procIsClipboardFormatAvailable    = moduser32.NewProc("IsClipboardFormatAvailable")
+	procRegisterClipboardFormat       = moduser32.NewProc("RegisterClipboardFormatW")
 	procBeginPaint                    = moduser32.NewProc("BeginPaint")
 	procEndPaint                      = moduser32.NewProc("EndPaint")
 	procGetKeyboardState              = moduser32.NewProc("GetKeyboardState")
 	procMapVirtualKey                 = moduser32.NewProc("MapVirtualKeyExW")
+	procGetKeyNameText                = moduser32.NewProc("GetKeyNameTextW")
+	procGetKeyboardLayout             = moduser32.NewProc("GetKeyboardLayout")
 	procGetAsyncKeyState              = moduser32.NewProc("GetAsyncKeyState")
 	procToAscii                       = moduser32.NewProc("ToAscii")
 	procSwapMouseButton               = moduser32.NewProc("SwapMouseButton")
@@ -134,6 +138,11 @@ var (
 	procGetParent                     = moduser32.NewProc("GetParent")
 	procFindWindowEx                  = moduser32.NewProc("FindWindowExW")
 	procChildWindowFromPoint          = moduser32.NewProc("ChildWindowFromPoint")
+	procWindowFromPoint               = moduser32.NewProc("WindowFromPoint")
+	procSetTimer                      = moduser32.NewProc("SetTimer")
+	procRegisterHotKey                = moduser32.NewProc("RegisterHotKey")
+	procUnregisterHotKey              = moduser32.NewProc("UnregisterHotKey")
+	procKillTimer                     = moduser32.NewProc("KillTimer")
 
 	libuser32, _        = syscall.LoadLibrary("user32.dll")
 	insertMenuItem, _   = syscall.GetProcAddress(libuser32, "InsertMenuItemW")
@@ -219,6 +228,17 @@ func PostMainThreadMessage(msg uint32, wp, lp uintptr) bool {
 	return ret != 0
 }
 
+// MainThread is the id of the thread that loaded the package.
+func MainThread() HANDLE {
+	return mainThread
+}
+
+func RegisterWindowMessage(name string) uint32 {
+	ret, _, _ := procRegisterWindowMessage.Call(
+		uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(name))))
+	return uint32(ret)
+}
+
 func CreateWindowEx(exStyle uint, className, windowName *uint16,
 	style uint, x, y, width, height int, parent HWND, menu HMENU,
 	instance HINSTANCE, param unsafe.Pointer) HWND {
@@ -973,6 +993,12 @@ func IsClipboardFormatAvailable(format uint) bool {
 	return ret != 0
 }
 
+func RegisterClipboardFormat(name string) uint {
+	ret, _, _ := procRegisterClipboardFormat.Call(
+		uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(name))))
+	return uint(ret)
+}
+
 func BeginPaint(hwnd HWND, paint *PAINTSTRUCT) HDC {
 	ret, _, _ := procBeginPaint.Call(
 		uintptr(hwnd),
@@ -1000,6 +1026,20 @@ func MapVirtualKeyEx(uCode, uMapType uint, dwhkl HKL) uint {
 	return uint(ret)
 }
 
+func GetKeyboardLayout(idThread uint32) HKL {
+	ret, _, _ := procGetKeyboardLayout.Call(uintptr(idThread))
+	return HKL(ret)
+}
+
+func GetKeyNameText(lParam int32) string {
+	var buf [64]uint16
+	ret, _, _ := procGetKeyNameText.Call(
+		uintptr(lParam),
+		uintptr(unsafe.Pointer(&buf[0])),
+		uintptr(len(buf)))
+	return syscall.UTF16ToString(buf[:ret])
+}
+
 func GetAsyncKeyState(vKey int) uint16 {
 	ret, _, _ := procGetAsyncKeyState.Call(uintptr(vKey))
 	return uint16(ret)
@@ -1280,3 +1320,47 @@ func ChildWindowFromPoint(hWndParent HWND, x, y int) HWND {
 	)
 	return HWND(ret)
 }
+
+// WindowFromPoint returns the window at the screen point x, y, the deepest
+// visible and enabled child if there is one.
+func WindowFromPoint(x, y int) HWND {
+	ret, _, _ := procWindowFromPoint.Call(pointArgs(x, y)...)
+	return HWND(ret)
+}
+
+func SetTimer(hwnd HWND, nIDEvent uintptr, uElapse uint32, lpTimerFunc uintptr) uintptr {
+	ret, _, _ := procSetTimer.Call(
+		uintptr(hwnd),
+		nIDEvent,
+		uintptr(uElapse),
+		lpTimerFunc)
+	return ret
+}
+
+func KillTimer(hwnd HWND, uIDEvent uintptr) bool {
+	ret, _, _ := procKillTimer.Call(
+		uintptr(hwnd),
+		uIDEvent)
+	return ret != 0
+}
+
+// RegisterHotKey returns the error of the call, ERROR_HOTKEY_ALREADY_REGISTERED
+// if another program has the hot key.
+func RegisterHotKey(hwnd HWND, id int32, fsModifiers, vk uint32) error {
+	ret, _, err := procRegisterHotKey.Call(
+		uintptr(hwnd),
+		uintptr(id),
+		uintptr(fsModifiers),
+		uintptr(vk))
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+func UnregisterHotKey(hwnd HWND, id int32) bool {
+	ret, _, _ := procUnregisterHotKey.Call(
+		uintptr(hwnd),
+		uintptr(id))
+	return ret != 0
+}
@@ -93,6 +93,11 @@ func generalWndProc(hwnd w32.HWND, msg uint32, wparam, lparam uintptr) uintptr {
 			// log.Println("NM_CUSTOMDRAW controller", controller.Speak(), msg, ret)
 			if controller := GetMsgHandler(nm.HwndFrom); controller != nil {
 				ret := controller.WndProc(msg, wparam, lparam)
+				switch int32(nm.Code) {
+				case w32.NM_CUSTOMDRAW, w32.LVN_ODFINDITEMW:
+					// The parent window procedure result is read, not DWL_MSGRESULT.
+					return ret
+				}
 				if ret != 0 {
 					w32.SetWindowLong(hwnd, w32.DWL_MSGRESULT, uint32(ret))
 					return w32.TRUE
@@ -115,6 +120,10 @@ func generalWndProc(hwnd w32.HWND, msg uint32, wparam, lparam uintptr) uintptr {
 			controller.OnKillFocus().Fire(NewEvent(controller, nil))
 		case w32.WM_SETFOCUS:
 			controller.OnSetFocus().Fire(NewEvent(controller, nil))
+		case w32.WM_CLIPBOARDUPDATE:
+			if clipboard := gClipboardListeners[hwnd]; clipboard != nil {
+				clipboard.onChange.Fire(NewEvent(controller, nil))
+			}
 		case w32.WM_DROPFILES:
 			controller.OnDropFiles().Fire(NewEvent(controller, genDropFilesEventArg(wparam)))
 		case w32.WM_CONTEXTMENU: